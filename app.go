@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"lottery-optimizer-gui/internal/ai"
@@ -78,6 +79,7 @@ type App struct {
 	contestPredictor *services.ContestPredictor // Nova feature: Preditor de Concursos Quentes
 	updateStatus     *UpdateStatus              // Status de atualização para o frontend
 	pendingUpdate    *updater.UpdateInfo        // Informações da atualização pendente
+	backfills        *data.BackfillManager      // Backfills do histórico em andamento
 }
 
 // UpdateStatus representa o status atual da atualização
@@ -171,6 +173,7 @@ func NewApp() *App {
 		contestPredictor: contestPredictor,
 		updateStatus:     &UpdateStatus{},
 		pendingUpdate:    nil,
+		backfills:        data.NewBackfillManager(),
 	}
 }
 
@@ -219,6 +222,9 @@ type UserPreferences struct {
 	AvoidPatterns   bool     `json:"avoidPatterns"`
 	FavoriteNumbers []int    `json:"favoriteNumbers"`
 	ExcludeNumbers  []int    `json:"excludeNumbers"`
+
+	// Palpites extras por loteria, ex: {"timemania": "FLAMENGO/RJ", "diadesorte": "Agosto"}
+	ExtraPicks map[string]string `json:"extraPicks,omitempty"`
 }
 
 // StrategyResponse resposta da geração de estratégia
@@ -415,12 +421,22 @@ func (a *App) GenerateStrategy(preferences UserPreferences) StrategyResponse {
 
 	// Converter tipos de loteria
 	for _, ltype := range preferences.LotteryTypes {
-		switch ltype {
-		case "megasena":
-			internalPrefs.LotteryTypes = append(internalPrefs.LotteryTypes, lottery.MegaSena)
-		case "lotofacil":
-			internalPrefs.LotteryTypes = append(internalPrefs.LotteryTypes, lottery.Lotofacil)
+		if def, ok := lottery.Lookup(ltype); ok {
+			internalPrefs.LotteryTypes = append(internalPrefs.LotteryTypes, def.Type)
+		}
+	}
+
+	// Converter palpites extras (Time do Coração, Mês da Sorte)
+	for ltype, pick := range preferences.ExtraPicks {
+		normalized, err := lottery.NormalizeExtraPick(lottery.LotteryType(ltype), pick)
+		if err != nil {
+			customLogger.Printf("⚠️ Palpite extra ignorado para %s: %v", ltype, err)
+			continue
 		}
+		if internalPrefs.ExtraPicks == nil {
+			internalPrefs.ExtraPicks = make(map[lottery.LotteryType]string)
+		}
+		internalPrefs.ExtraPicks[lottery.LotteryType(ltype)] = normalized
 	}
 
 	// Buscar dados históricos com lógica de fallback
@@ -430,7 +446,8 @@ func (a *App) GenerateStrategy(preferences UserPreferences) StrategyResponse {
 	var failedLotteries []lottery.LotteryType
 
 	for _, ltype := range internalPrefs.LotteryTypes {
-		draws, err := a.dataClient.GetLatestDraws(ltype, 250) // 250 sorteios POR LOTERIA
+		// Todo o histórico guardado (completo após o backfill), com no mínimo os 250 sorteios mais recentes
+		draws, err := a.dataClient.GetHistory(ltype, 250)
 		if err != nil {
 			failedLotteries = append(failedLotteries, ltype)
 			continue
@@ -440,6 +457,14 @@ func (a *App) GenerateStrategy(preferences UserPreferences) StrategyResponse {
 		allRules = append(allRules, lottery.GetRules(ltype))
 		availableLotteries = append(availableLotteries, ltype)
 
+		// Prêmio estimado pela CAIXA para o próximo concurso entra no retorno esperado dos jogos
+		if len(draws) > 0 && draws[0].EstimatedNextPrize > 0 {
+			if internalPrefs.PrizeEstimates == nil {
+				internalPrefs.PrizeEstimates = make(map[lottery.LotteryType]float64)
+			}
+			internalPrefs.PrizeEstimates[ltype] = draws[0].EstimatedNextPrize
+		}
+
 		// Log para confirmar quantos dados foram carregados por loteria
 		if config.IsVerbose() {
 			customLogger.Printf("✅ Carregados %d sorteios históricos de %s", len(draws), ltype)
@@ -457,7 +482,7 @@ func (a *App) GenerateStrategy(preferences UserPreferences) StrategyResponse {
 	if len(internalPrefs.LotteryTypes) == 1 && len(failedLotteries) > 0 {
 		return StrategyResponse{
 			Success: false,
-			Error:   fmt.Sprintf("Loteria %s indisponível. Tente novamente mais tarde ou inclua ambas as loterias.", failedLotteries[0]),
+			Error:   fmt.Sprintf("Loteria %s indisponível. Tente novamente mais tarde ou inclua outras loterias.", failedLotteries[0]),
 		}
 	}
 
@@ -572,43 +597,78 @@ func (a *App) GenerateStrategy(preferences UserPreferences) StrategyResponse {
 func (a *App) GetNextDraws() map[string]interface{} {
 	result := make(map[string]interface{})
 
-	// Mega Sena
-	if nextDate, nextNum, err := a.dataClient.GetNextDrawInfo(lottery.MegaSena); err == nil {
-		result["megasena"] = map[string]interface{}{
-			"number": nextNum,
-			"date":   nextDate.Format("02/01/2006"),
+	// As loterias são consultadas em paralelo; só as que não têm sorteio guardado vão à API,
+	// e essas requisições passam pelo limite de requisições do cliente
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, def := range lottery.All() {
+		wg.Add(1)
+		go func(ltype lottery.LotteryType) {
+			defer wg.Done()
+
+			contests, err := a.dataClient.UpcomingContests(ltype, 1)
+			if err != nil {
+				return
+			}
+			next := map[string]interface{}{
+				"number": contests[0].Number,
+				"date":   contests[0].Date.Format("02/01/2006"),
+			}
+			if contests[0].Special != "" {
+				next["special"] = contests[0].Special
+			}
+
+			mu.Lock()
+			result[string(ltype)] = next
+			mu.Unlock()
+		}(def.Type)
+	}
+	wg.Wait()
+
+	return result
+}
+
+// GetContestCalendar projeta os próximos concursos de uma loteria, com feriados e concursos especiais
+// Usa o último sorteio guardado como ponto de partida, por isso funciona sem acesso à rede
+func (a *App) GetContestCalendar(lotteryType string, count int) map[string]interface{} {
+	ltype, err := lottery.ParseLotteryType(lotteryType)
+	if err != nil {
+		return map[string]interface{}{
+			"success": false,
+			"error":   err.Error(),
 		}
 	}
+	if count <= 0 {
+		count = 10
+	}
 
-	// Lotofácil
-	if nextDate, nextNum, err := a.dataClient.GetNextDrawInfo(lottery.Lotofacil); err == nil {
-		result["lotofacil"] = map[string]interface{}{
-			"number": nextNum,
-			"date":   nextDate.Format("02/01/2006"),
+	contests, err := a.dataClient.UpcomingContests(ltype, count)
+	if err != nil {
+		return map[string]interface{}{
+			"success": false,
+			"error":   fmt.Sprintf("Erro ao projetar calendário: %v", err),
 		}
 	}
 
-	return result
+	return map[string]interface{}{
+		"success":  true,
+		"contests": contests,
+	}
 }
 
 // GetStatistics retorna estatísticas das loterias
 func (a *App) GetStatistics() map[string]interface{} {
 	result := make(map[string]interface{})
 
-	// Buscar dados para estatísticas (usando mais dados para melhor precisão)
-	megaDraws, err := a.dataClient.GetLatestDraws(lottery.MegaSena, 50)
-	if err == nil {
-		result["megasena"] = map[string]interface{}{
-			"totalDraws": len(megaDraws),
-			"lastDraw":   megaDraws[0].Number,
-		}
-	}
-
-	lotofacilDraws, err := a.dataClient.GetLatestDraws(lottery.Lotofacil, 50)
-	if err == nil {
-		result["lotofacil"] = map[string]interface{}{
-			"totalDraws": len(lotofacilDraws),
-			"lastDraw":   lotofacilDraws[0].Number,
+	// Buscar dados para estatísticas (todo o histórico guardado, para melhor precisão)
+	for _, def := range lottery.All() {
+		draws, err := a.dataClient.GetHistory(def.Type, 50)
+		if err == nil && len(draws) > 0 {
+			result[string(def.Type)] = map[string]interface{}{
+				"totalDraws": len(draws),
+				"lastDraw":   draws[0].Number,
+				"firstDraw":  draws[len(draws)-1].Number,
+			}
 		}
 	}
 
@@ -981,7 +1041,7 @@ func (a *App) SaveGame(request models.SaveGameRequest) map[string]interface{} {
 		}
 	}
 
-	if len(request.Numbers) == 0 {
+	if len(request.Numbers) == 0 && len(request.Columns) == 0 && len(request.Picks) == 0 {
 		logs.LogError(logs.CategoryDatabase, "❌ Nenhum número informado")
 		return map[string]interface{}{
 			"success": false,
@@ -989,6 +1049,22 @@ func (a *App) SaveGame(request models.SaveGameRequest) map[string]interface{} {
 		}
 	}
 
+	// Gravar sempre o identificador canônico (ex: "mega-sena" -> "megasena")
+	def, ok := lottery.Lookup(request.LotteryType)
+	if !ok {
+		logs.LogError(logs.CategoryDatabase, "❌ Tipo de loteria inválido: %s", request.LotteryType)
+		return map[string]interface{}{
+			"success": false,
+			"error":   fmt.Sprintf("Tipo de loteria não suportado: %s", request.LotteryType),
+		}
+	}
+	request.LotteryType = string(def.Type)
+
+	// Sem concurso ou data, o calendário da loteria completa a partir do próximo concurso
+	if request.ContestNumber <= 0 || request.ExpectedDraw == "" {
+		a.fillContestFromCalendar(&request, def.Type)
+	}
+
 	if request.ExpectedDraw == "" {
 		logs.LogError(logs.CategoryDatabase, "❌ Data do sorteio não informada")
 		return map[string]interface{}{
@@ -1005,6 +1081,67 @@ func (a *App) SaveGame(request models.SaveGameRequest) map[string]interface{} {
 		}
 	}
 
+	if request.Mirror && !def.Rules.MirrorBets {
+		logs.LogError(logs.CategoryDatabase, "❌ Aposta espelho solicitada para %s", request.LotteryType)
+		return map[string]interface{}{
+			"success": false,
+			"error":   fmt.Sprintf("Aposta espelho não está disponível para %s", request.LotteryType),
+		}
+	}
+
+	// Todo jogo é validado pelas regras da loteria: dezenas, colunas, palpites, trevos e palpite extra
+	candidate := lottery.Game{
+		Type:      def.Type,
+		Numbers:   request.Numbers,
+		Secondary: request.Secondary,
+		Columns:   request.Columns,
+		Picks:     request.Picks,
+	}
+	if request.ExtraPick != nil {
+		candidate.ExtraPick = request.ExtraPick.Choice
+	}
+	if err := lottery.ValidateGame(candidate); err != nil {
+		logs.LogError(logs.CategoryDatabase, "❌ Jogo inválido para %s: %v", request.LotteryType, err)
+		return map[string]interface{}{
+			"success": false,
+			"error":   fmt.Sprintf("Aposta inválida: %v", err),
+		}
+	}
+
+	// Palpites por partida (Loteca) são gravados na forma canônica
+	if def.Rules.IsMatchPool() {
+		request.Picks, _ = lottery.NormalizeMatchPicks(request.Picks)
+	}
+
+	// Palpite extra (Time do Coração, Mês da Sorte) gravado na forma canônica
+	if request.ExtraPick != nil {
+		normalized, err := lottery.NormalizeExtraPick(def.Type, request.ExtraPick.Choice)
+		if err != nil || !def.Rules.HasExtraPick() {
+			logs.LogError(logs.CategoryDatabase, "❌ Palpite extra inválido para %s: %v", request.LotteryType, err)
+			return map[string]interface{}{
+				"success": false,
+				"error":   fmt.Sprintf("Palpite extra inválido para %s", request.LotteryType),
+			}
+		}
+		request.ExtraPick = &models.ExtraPick{Kind: string(def.Rules.ExtraPickKind), Choice: normalized}
+	}
+
+	// Jogos de bolão só entram em bolões abertos
+	if request.PoolID != "" {
+		if err := a.savedGamesDB.RequireOpenPool(request.PoolID); err != nil {
+			logs.LogError(logs.CategoryDatabase, "❌ Bolão inválido para o jogo: %v", err)
+			return map[string]interface{}{
+				"success": false,
+				"error":   fmt.Sprintf("Bolão inválido: %v", err),
+			}
+		}
+	}
+
+	// Teimosinha: a mesma aposta em concursos consecutivos, paga de uma vez
+	if request.Contests > 1 {
+		return a.saveRecurringBet(request)
+	}
+
 	// Tentar salvar no banco
 	logs.LogDatabase("💾 Salvando no banco de dados...")
 	game, err := a.savedGamesDB.SaveGame(request)
@@ -1018,11 +1155,108 @@ func (a *App) SaveGame(request models.SaveGameRequest) map[string]interface{} {
 
 	logs.LogDatabase("✅ Jogo salvo com sucesso! ID: %s", game.ID)
 
-	return map[string]interface{}{
+	result := map[string]interface{}{
 		"success": true,
 		"game":    game,
 		"message": "Jogo salvo com sucesso!",
 	}
+
+	if request.Mirror {
+		mirrorGame, err := a.saveMirrorGame(request)
+		if err != nil {
+			logs.LogError(logs.CategoryDatabase, "❌ Erro ao salvar aposta espelho: %v", err)
+			result["message"] = fmt.Sprintf("Jogo salvo, mas a aposta espelho falhou: %v", err)
+			return result
+		}
+		result["mirrorGame"] = mirrorGame
+		result["message"] = "Jogo e aposta espelho salvos com sucesso!"
+	}
+
+	return result
+}
+
+// fillContestFromCalendar completa o concurso e a data do sorteio de um jogo pelo calendário da loteria
+// Sem concurso informado usa o próximo; com concurso e sem data, busca a data dele entre os próximos
+func (a *App) fillContestFromCalendar(request *models.SaveGameRequest, ltype lottery.LotteryType) {
+	contests, err := a.dataClient.UpcomingContests(ltype, lottery.MaxRecurringContests)
+	if err != nil {
+		logs.LogError(logs.CategoryDatabase, "❌ Erro ao projetar concurso de %s: %v", ltype, err)
+		return
+	}
+
+	if request.ContestNumber <= 0 {
+		request.ContestNumber = contests[0].Number
+		request.ExpectedDraw = contests[0].Date.Format("2006-01-02")
+		logs.LogDatabase("📅 Concurso %d (%s) definido pelo calendário", request.ContestNumber, request.ExpectedDraw)
+		return
+	}
+
+	for _, contest := range contests {
+		if contest.Number == request.ContestNumber {
+			request.ExpectedDraw = contest.Date.Format("2006-01-02")
+			logs.LogDatabase("📅 Data do concurso %d (%s) definida pelo calendário", request.ContestNumber, request.ExpectedDraw)
+			return
+		}
+	}
+}
+
+// saveMirrorGame salva a aposta espelho (ex: as 50 dezenas não escolhidas na Lotomania)
+func (a *App) saveMirrorGame(request models.SaveGameRequest) (*models.SavedGame, error) {
+	def, _ := lottery.Lookup(request.LotteryType)
+	rules := def.Rules
+
+	mirrorRequest := request
+	mirrorRequest.Numbers = lottery.MirrorNumbers(request.Numbers, rules.NumberRange)
+	mirrorRequest.Mirror = false
+
+	logs.LogDatabase("🪞 Salvando aposta espelho: %v", mirrorRequest.Numbers)
+	return a.savedGamesDB.SaveGame(mirrorRequest)
+}
+
+// saveRecurringBet salva uma Teimosinha (e a sequência espelho, se pedida) já validada
+func (a *App) saveRecurringBet(request models.SaveGameRequest) map[string]interface{} {
+	if err := lottery.ValidateRecurringContests(request.Contests); err != nil {
+		logs.LogError(logs.CategoryDatabase, "❌ Teimosinha inválida: %v", err)
+		return map[string]interface{}{
+			"success": false,
+			"error":   fmt.Sprintf("Teimosinha inválida: %v", err),
+		}
+	}
+
+	logs.LogDatabase("🔁 Salvando Teimosinha de %d concursos a partir do %d...", request.Contests, request.ContestNumber)
+	bet, err := a.savedGamesDB.SaveRecurringBet(request)
+	if err != nil {
+		logs.LogError(logs.CategoryDatabase, "❌ Erro ao salvar Teimosinha: %v", err)
+		return map[string]interface{}{
+			"success": false,
+			"error":   fmt.Sprintf("Erro ao salvar Teimosinha: %v", err),
+		}
+	}
+
+	result := map[string]interface{}{
+		"success":      true,
+		"recurringBet": bet,
+		"message":      fmt.Sprintf("Teimosinha salva: %d concursos, custo total R$ %.2f", bet.Contests, bet.TotalCost),
+	}
+
+	if request.Mirror {
+		def, _ := lottery.Lookup(request.LotteryType)
+		mirrorRequest := request
+		mirrorRequest.Numbers = lottery.MirrorNumbers(request.Numbers, def.Rules.NumberRange)
+		mirrorRequest.Mirror = false
+
+		mirrorBet, err := a.savedGamesDB.SaveRecurringBet(mirrorRequest)
+		if err != nil {
+			logs.LogError(logs.CategoryDatabase, "❌ Erro ao salvar Teimosinha espelho: %v", err)
+			result["message"] = fmt.Sprintf("Teimosinha salva, mas a aposta espelho falhou: %v", err)
+			return result
+		}
+		result["mirrorRecurringBet"] = mirrorBet
+		result["message"] = fmt.Sprintf("Teimosinha e aposta espelho salvas: %d concursos, custo total R$ %.2f",
+			bet.Contests, bet.TotalCost+mirrorBet.TotalCost)
+	}
+
+	return result
 }
 
 // SaveManualGame salva um jogo adicionado manualmente pelo usuário
@@ -1048,61 +1282,97 @@ func (a *App) SaveManualGame(request models.SaveGameRequest) map[string]interfac
 	}
 
 	// Validar tipo de loteria
-	if request.LotteryType != "mega-sena" && request.LotteryType != "lotofacil" {
+	def, ok := lottery.Lookup(request.LotteryType)
+	if !ok {
+		var supported []string
+		for _, d := range lottery.All() {
+			supported = append(supported, fmt.Sprintf("'%s'", d.Type))
+		}
 		logs.LogError(logs.CategoryDatabase, "❌ Tipo de loteria inválido: %s", request.LotteryType)
 		return map[string]interface{}{
 			"success": false,
-			"error":   "Tipo de loteria deve ser 'mega-sena' ou 'lotofacil'",
+			"error":   fmt.Sprintf("Tipo de loteria deve ser um de: %s", strings.Join(supported, ", ")),
 		}
 	}
+	rules := def.Rules
+	request.LotteryType = string(def.Type)
 
-	if len(request.Numbers) == 0 {
-		logs.LogError(logs.CategoryDatabase, "❌ Nenhum número informado")
+	if len(request.Picks) > 0 && !rules.IsMatchPool() {
+		logs.LogError(logs.CategoryDatabase, "❌ Palpites por partida informados para %s", request.LotteryType)
 		return map[string]interface{}{
 			"success": false,
-			"error":   "Pelo menos um número deve ser informado",
+			"error":   fmt.Sprintf("%s não aceita palpites por partida", rules.Name),
 		}
 	}
 
-	// Validações específicas por loteria
-	if request.LotteryType == "mega-sena" {
-		if len(request.Numbers) < 6 || len(request.Numbers) > 15 {
-			logs.LogError(logs.CategoryDatabase, "❌ Mega-Sena: números inválidos (%d), deve ter entre 6 e 15", len(request.Numbers))
-			return map[string]interface{}{
-				"success": false,
-				"error":   "Mega-Sena deve ter entre 6 e 15 números",
-			}
+	if len(request.Columns) > 0 && !rules.IsPositional() {
+		logs.LogError(logs.CategoryDatabase, "❌ Aposta por colunas informada para %s", request.LotteryType)
+		return map[string]interface{}{
+			"success": false,
+			"error":   fmt.Sprintf("%s não aceita aposta por colunas", rules.Name),
 		}
-		// Verificar se números estão no range 1-60
-		for _, num := range request.Numbers {
-			if num < 1 || num > 60 {
-				logs.LogError(logs.CategoryDatabase, "❌ Mega-Sena: número %d fora do range (1-60)", num)
-				return map[string]interface{}{
-					"success": false,
-					"error":   fmt.Sprintf("Mega-Sena: número %d deve estar entre 1 e 60", num),
-				}
-			}
+	}
+
+	if request.ExtraPick != nil && !rules.HasExtraPick() {
+		logs.LogError(logs.CategoryDatabase, "❌ Palpite extra informado para %s", request.LotteryType)
+		return map[string]interface{}{
+			"success": false,
+			"error":   fmt.Sprintf("%s não aceita palpite extra", rules.Name),
 		}
-	} else if request.LotteryType == "lotofacil" {
-		if len(request.Numbers) < 15 || len(request.Numbers) > 20 {
-			logs.LogError(logs.CategoryDatabase, "❌ Lotofácil: números inválidos (%d), deve ter entre 15 e 20", len(request.Numbers))
-			return map[string]interface{}{
-				"success": false,
-				"error":   "Lotofácil deve ter entre 15 e 20 números",
-			}
+	}
+
+	if len(request.Secondary) > 0 && !rules.HasSecondary() {
+		logs.LogError(logs.CategoryDatabase, "❌ Seleção secundária informada para %s", request.LotteryType)
+		return map[string]interface{}{
+			"success": false,
+			"error":   fmt.Sprintf("%s não aceita seleção secundária", rules.Name),
 		}
-		// Verificar se números estão no range 1-25
-		for _, num := range request.Numbers {
-			if num < 1 || num > 25 {
-				logs.LogError(logs.CategoryDatabase, "❌ Lotofácil: número %d fora do range (1-25)", num)
-				return map[string]interface{}{
-					"success": false,
-					"error":   fmt.Sprintf("Lotofácil: número %d deve estar entre 1 e 25", num),
-				}
-			}
+	}
+
+	if request.Mirror && !rules.MirrorBets {
+		logs.LogError(logs.CategoryDatabase, "❌ Aposta espelho solicitada para %s", request.LotteryType)
+		return map[string]interface{}{
+			"success": false,
+			"error":   fmt.Sprintf("%s não aceita aposta espelho", rules.Name),
+		}
+	}
+
+	if len(request.Numbers) == 0 && len(request.Columns) == 0 && len(request.Picks) == 0 {
+		logs.LogError(logs.CategoryDatabase, "❌ Nenhum número informado")
+		return map[string]interface{}{
+			"success": false,
+			"error":   "Pelo menos um número deve ser informado",
 		}
 	}
 
+	// Validações específicas por loteria seguem as regras registradas
+	candidate := lottery.Game{
+		Type:      def.Type,
+		Numbers:   request.Numbers,
+		Secondary: request.Secondary,
+		Columns:   request.Columns,
+		Picks:     request.Picks,
+	}
+	if request.ExtraPick != nil {
+		candidate.ExtraPick = request.ExtraPick.Choice
+	}
+	if err := lottery.ValidateGame(candidate); err != nil {
+		logs.LogError(logs.CategoryDatabase, "❌ %s: %v", rules.Name, err)
+		return map[string]interface{}{
+			"success": false,
+			"error":   fmt.Sprintf("%s: %v", rules.Name, err),
+		}
+	}
+
+	// Gravar palpites na forma canônica (ex: "8" -> "Agosto", "x1" -> "1X")
+	if rules.HasExtraPick() {
+		normalized, _ := lottery.NormalizeExtraPick(def.Type, candidate.ExtraPick)
+		request.ExtraPick = &models.ExtraPick{Kind: string(rules.ExtraPickKind), Choice: normalized}
+	}
+	if rules.IsMatchPool() {
+		request.Picks, _ = lottery.NormalizeMatchPicks(request.Picks)
+	}
+
 	// Verificar duplicatas
 	seen := make(map[int]bool)
 	for _, num := range request.Numbers {
@@ -1116,6 +1386,11 @@ func (a *App) SaveManualGame(request models.SaveGameRequest) map[string]interfac
 		seen[num] = true
 	}
 
+	// Sem concurso ou data, o calendário da loteria completa a partir do próximo concurso
+	if request.ContestNumber <= 0 || request.ExpectedDraw == "" {
+		a.fillContestFromCalendar(&request, def.Type)
+	}
+
 	if request.ExpectedDraw == "" {
 		logs.LogError(logs.CategoryDatabase, "❌ Data do sorteio não informada")
 		return map[string]interface{}{
@@ -1132,6 +1407,22 @@ func (a *App) SaveManualGame(request models.SaveGameRequest) map[string]interfac
 		}
 	}
 
+	// Jogos de bolão só entram em bolões abertos
+	if request.PoolID != "" {
+		if err := a.savedGamesDB.RequireOpenPool(request.PoolID); err != nil {
+			logs.LogError(logs.CategoryDatabase, "❌ Bolão inválido para o jogo: %v", err)
+			return map[string]interface{}{
+				"success": false,
+				"error":   fmt.Sprintf("Bolão inválido: %v", err),
+			}
+		}
+	}
+
+	// Teimosinha registrada manualmente: um jogo por concurso da sequência
+	if request.Contests > 1 {
+		return a.saveRecurringBet(request)
+	}
+
 	// Tentar salvar no banco
 	logs.LogDatabase("💾 Salvando jogo manual no banco de dados...")
 	game, err := a.savedGamesDB.SaveGame(request)
@@ -1145,11 +1436,24 @@ func (a *App) SaveManualGame(request models.SaveGameRequest) map[string]interfac
 
 	logs.LogDatabase("✅ Jogo manual salvo com sucesso! ID: %s", game.ID)
 
-	return map[string]interface{}{
+	result := map[string]interface{}{
 		"success": true,
 		"game":    game,
 		"message": "Jogo adicionado manualmente com sucesso!",
 	}
+
+	if request.Mirror {
+		mirrorGame, err := a.saveMirrorGame(request)
+		if err != nil {
+			logs.LogError(logs.CategoryDatabase, "❌ Erro ao salvar aposta espelho: %v", err)
+			result["message"] = fmt.Sprintf("Jogo adicionado, mas a aposta espelho falhou: %v", err)
+			return result
+		}
+		result["mirrorGame"] = mirrorGame
+		result["message"] = "Jogo e aposta espelho adicionados com sucesso!"
+	}
+
+	return result
 }
 
 // GetSavedGames busca jogos salvos com filtros opcionais
@@ -1237,6 +1541,52 @@ func (a *App) GetNumberFrequencyAnalysis(lotteryType string) map[string]interfac
 	}
 }
 
+// GetLotecaFixtures retorna a grade de partidas de um concurso da Loteca
+func (a *App) GetLotecaFixtures(contestNumber int) map[string]interface{} {
+	if contestNumber <= 0 {
+		_, nextNum, err := a.dataClient.GetNextDrawInfo(lottery.Loteca)
+		if err != nil {
+			return map[string]interface{}{
+				"success": false,
+				"error":   fmt.Sprintf("Erro ao buscar próximo concurso da Loteca: %v", err),
+			}
+		}
+		contestNumber = nextNum
+	}
+
+	matches, err := a.dataClient.GetLotecaFixtures(contestNumber)
+	if err != nil {
+		return map[string]interface{}{
+			"success": false,
+			"error":   err.Error(),
+		}
+	}
+
+	return map[string]interface{}{
+		"success":       true,
+		"contestNumber": contestNumber,
+		"matches":       matches,
+	}
+}
+
+// GetColumnFrequencyAnalysis retorna a frequência dos dígitos por coluna nas apostas posicionais (ex: Super Sete)
+func (a *App) GetColumnFrequencyAnalysis(lotteryType string) map[string]interface{} {
+	columns, err := analytics.GetColumnFrequencyAnalysis(lotteryType)
+	if err != nil {
+		return map[string]interface{}{
+			"success": false,
+			"error":   err.Error(),
+		}
+	}
+
+	return map[string]interface{}{
+		"success":      true,
+		"columns":      columns,
+		"lotteryType":  lotteryType,
+		"totalColumns": len(columns),
+	}
+}
+
 // GetROICalculator retorna cálculos detalhados de ROI
 func (a *App) GetROICalculator(investment float64, timeframe string) map[string]interface{} {
 	metrics, err := analytics.CalculatePerformanceMetrics()
@@ -1732,9 +2082,38 @@ func (a *App) CheckGameResult(gameID string) map[string]interface{} {
 	}
 }
 
-// CheckAllPendingResults verifica todos os jogos pendentes
-func (a *App) CheckAllPendingResults() map[string]interface{} {
-	logs.LogDatabase("🔄 Iniciando verificação de todos os jogos pendentes")
+// GetRecurringBets lista as Teimosinhas com o resultado consolidado de cada sequência
+func (a *App) GetRecurringBets() map[string]interface{} {
+	if a.savedGamesDB == nil {
+		return map[string]interface{}{
+			"success": false,
+			"error":   "Banco de dados de jogos salvos não disponível",
+		}
+	}
+
+	bets, err := a.savedGamesDB.GetRecurringBets()
+	if err != nil {
+		logs.LogError(logs.CategoryDatabase, "❌ Erro ao buscar Teimosinhas: %v", err)
+		return map[string]interface{}{
+			"success": false,
+			"error":   fmt.Sprintf("Erro ao buscar Teimosinhas: %v", err),
+		}
+	}
+
+	for i := range bets {
+		bets[i].Result = services.RollUpRecurringBet(&bets[i])
+	}
+
+	return map[string]interface{}{
+		"success": true,
+		"bets":    bets,
+		"total":   len(bets),
+	}
+}
+
+// CheckRecurringBet confere os concursos pendentes de uma Teimosinha e retorna o resultado consolidado
+func (a *App) CheckRecurringBet(recurringID string) map[string]interface{} {
+	logs.LogDatabase("🔁 Verificando Teimosinha %s", recurringID)
 
 	if a.savedGamesDB == nil || a.resultChecker == nil {
 		logs.LogError(logs.CategoryDatabase, "❌ Sistema de verificação não disponível")
@@ -1744,6 +2123,531 @@ func (a *App) CheckAllPendingResults() map[string]interface{} {
 		}
 	}
 
+	bet, err := a.resultChecker.CheckRecurringBet(recurringID)
+	if err != nil {
+		logs.LogError(logs.CategoryDatabase, "❌ Erro ao verificar Teimosinha: %v", err)
+		return map[string]interface{}{
+			"success": false,
+			"error":   fmt.Sprintf("Erro ao verificar Teimosinha: %v", err),
+		}
+	}
+
+	logs.LogDatabase("✅ Teimosinha %s: %d/%d concursos conferidos, prêmios R$ %.2f",
+		bet.ID, bet.Result.CheckedContests, bet.Contests, bet.Result.TotalPrize)
+
+	return map[string]interface{}{
+		"success":      true,
+		"recurringBet": bet,
+		"result":       bet.Result,
+	}
+}
+
+// ===============================
+// HISTÓRICO DE SORTEIOS
+// ===============================
+
+// StartBackfill inicia em segundo plano o download do histórico completo de uma loteria
+// Concursos já guardados são pulados, então chamar de novo retoma um backfill interrompido
+func (a *App) StartBackfill(lotteryType string) map[string]interface{} {
+	ltype, err := lottery.ParseLotteryType(lotteryType)
+	if err != nil {
+		return map[string]interface{}{
+			"success": false,
+			"error":   err.Error(),
+		}
+	}
+
+	progress, err := a.backfills.Start(a.dataClient, ltype)
+	if err != nil {
+		return map[string]interface{}{
+			"success":  false,
+			"error":    err.Error(),
+			"progress": progress,
+		}
+	}
+
+	logs.LogData("📚 Backfill de %s iniciado", ltype)
+	return map[string]interface{}{
+		"success":  true,
+		"progress": progress,
+		"message":  "Download do histórico iniciado",
+	}
+}
+
+// GetBackfillProgress retorna o andamento do backfill (concluídos/total, ETA e erros)
+// Com lotteryType vazio, retorna o andamento de todas as loterias
+func (a *App) GetBackfillProgress(lotteryType string) map[string]interface{} {
+	if lotteryType == "" {
+		return map[string]interface{}{
+			"success":  true,
+			"progress": a.backfills.AllProgress(),
+		}
+	}
+
+	ltype, err := lottery.ParseLotteryType(lotteryType)
+	if err != nil {
+		return map[string]interface{}{
+			"success": false,
+			"error":   err.Error(),
+		}
+	}
+
+	progress, ok := a.backfills.Progress(ltype)
+	if !ok {
+		return map[string]interface{}{
+			"success": true,
+			"started": false,
+		}
+	}
+
+	return map[string]interface{}{
+		"success":  true,
+		"started":  true,
+		"progress": progress,
+		"percent":  progress.Percent(),
+	}
+}
+
+// CancelBackfill interrompe o backfill de uma loteria; o que já foi baixado fica guardado
+func (a *App) CancelBackfill(lotteryType string) map[string]interface{} {
+	ltype, err := lottery.ParseLotteryType(lotteryType)
+	if err != nil {
+		return map[string]interface{}{
+			"success": false,
+			"error":   err.Error(),
+		}
+	}
+
+	if !a.backfills.Cancel(ltype) {
+		return map[string]interface{}{
+			"success": false,
+			"error":   "Nenhum backfill em andamento para esta loteria",
+		}
+	}
+
+	return map[string]interface{}{
+		"success": true,
+		"message": "Backfill interrompido",
+	}
+}
+
+// ImportResultsFile importa a planilha oficial de resultados da CAIXA (XLSX ou HTML) de uma loteria
+func (a *App) ImportResultsFile(lotteryType string, filePath string) map[string]interface{} {
+	ltype, err := lottery.ParseLotteryType(lotteryType)
+	if err != nil {
+		return map[string]interface{}{
+			"success": false,
+			"error":   err.Error(),
+		}
+	}
+
+	result, err := a.dataClient.ImportResultsFile(ltype, filePath)
+	if err != nil {
+		logs.LogError(logs.CategoryData, "❌ Erro ao importar planilha %s: %v", filePath, err)
+		return map[string]interface{}{
+			"success": false,
+			"error":   fmt.Sprintf("Erro ao importar planilha: %v", err),
+		}
+	}
+
+	return map[string]interface{}{
+		"success": true,
+		"result":  result,
+		"message": fmt.Sprintf("%d concursos importados (%d a %d)", result.Imported, result.FirstContest, result.LastContest),
+	}
+}
+
+// AuditDraws confere a integridade dos sorteios guardados de uma loteria
+// Com repair, busca de novo em qualquer fonte disponível os concursos ausentes ou inválidos
+func (a *App) AuditDraws(lotteryType string, repair bool) map[string]interface{} {
+	ltype, err := lottery.ParseLotteryType(lotteryType)
+	if err != nil {
+		return map[string]interface{}{
+			"success": false,
+			"error":   err.Error(),
+		}
+	}
+
+	var report data.AuditReport
+	if repair {
+		ctx := a.ctx
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		report, err = a.dataClient.RepairDraws(ctx, ltype)
+	} else {
+		report, err = a.dataClient.AuditStoredDraws(ltype)
+	}
+	if err != nil {
+		logs.LogError(logs.CategoryData, "❌ Erro na auditoria de %s: %v", ltype, err)
+		return map[string]interface{}{
+			"success": false,
+			"error":   fmt.Sprintf("Erro na auditoria: %v", err),
+			"report":  report,
+		}
+	}
+
+	message := fmt.Sprintf("%d sorteios conferidos, nenhum problema encontrado", report.Checked)
+	switch {
+	case repair && report.HasIssues():
+		message = fmt.Sprintf("%d problemas encontrados: %d concursos corrigidos, %d sem correção",
+			len(report.Issues), len(report.Repaired), len(report.Unrepaired))
+	case report.HasIssues():
+		message = fmt.Sprintf("%d problemas encontrados em %d sorteios", len(report.Issues), report.Checked)
+	}
+
+	return map[string]interface{}{
+		"success": true,
+		"report":  report,
+		"message": message,
+	}
+}
+
+// ParseManualDraw interpreta um resultado colado (ex: texto copiado do site da CAIXA) para conferência antes de salvar
+func (a *App) ParseManualDraw(lotteryType string, text string) map[string]interface{} {
+	ltype, err := lottery.ParseLotteryType(lotteryType)
+	if err != nil {
+		return map[string]interface{}{
+			"success": false,
+			"error":   err.Error(),
+		}
+	}
+
+	draw, err := data.ParseManualDraw(ltype, text)
+	if err != nil {
+		return map[string]interface{}{
+			"success": false,
+			"error":   fmt.Sprintf("Não foi possível interpretar o resultado: %v", err),
+		}
+	}
+
+	return map[string]interface{}{
+		"success": true,
+		"draw":    draw,
+	}
+}
+
+// SaveManualDraw guarda um resultado informado pelo usuário quando a API da CAIXA está fora do ar
+// O resultado fica marcado como manual e é substituído pelo oficial assim que a API responder
+func (a *App) SaveManualDraw(request models.ManualDrawRequest) map[string]interface{} {
+	ltype, err := lottery.ParseLotteryType(request.LotteryType)
+	if err != nil {
+		return map[string]interface{}{
+			"success": false,
+			"error":   err.Error(),
+		}
+	}
+
+	var date lottery.BrazilianDate
+	if err := date.UnmarshalJSON([]byte(`"` + strings.TrimSpace(request.DrawDate) + `"`)); err != nil {
+		return map[string]interface{}{
+			"success": false,
+			"error":   fmt.Sprintf("Data do sorteio inválida: %s", request.DrawDate),
+		}
+	}
+
+	draw := lottery.Draw{
+		Number:        request.ContestNumber,
+		Date:          date,
+		Numbers:       lottery.StringIntSlice(request.Numbers),
+		SecondNumbers: lottery.StringIntSlice(request.SecondNumbers),
+		Secondary:     lottery.StringIntSlice(request.Secondary),
+		ExtraPick:     request.ExtraPick,
+	}
+	for _, prize := range request.Prizes {
+		draw.Winners = append(draw.Winners, lottery.Winner{
+			Tier:    prize.Tier,
+			Winners: prize.Winners,
+			Prize:   prize.Prize,
+		})
+	}
+
+	saved, err := a.dataClient.SaveManualDraw(ltype, draw)
+	if err != nil {
+		logs.LogError(logs.CategoryData, "❌ Erro ao salvar resultado manual de %s %d: %v", ltype, request.ContestNumber, err)
+		return map[string]interface{}{
+			"success": false,
+			"error":   fmt.Sprintf("Resultado inválido: %v", err),
+		}
+	}
+
+	return map[string]interface{}{
+		"success": true,
+		"draw":    saved,
+		"message": fmt.Sprintf("Resultado manual do concurso %d salvo; será substituído pelo oficial quando a CAIXA voltar", saved.Number),
+	}
+}
+
+// GetDrawCorrections lista os resultados manuais já substituídos pelo oficial e as divergências encontradas
+// Com lotteryType vazio, lista todas as loterias
+func (a *App) GetDrawCorrections(lotteryType string) map[string]interface{} {
+	var ltype lottery.LotteryType
+	if lotteryType != "" {
+		parsed, err := lottery.ParseLotteryType(lotteryType)
+		if err != nil {
+			return map[string]interface{}{
+				"success": false,
+				"error":   err.Error(),
+			}
+		}
+		ltype = parsed
+	}
+
+	corrections, err := a.dataClient.DrawCorrections(ltype, false)
+	if err != nil {
+		return map[string]interface{}{
+			"success": false,
+			"error":   fmt.Sprintf("Erro ao buscar correções: %v", err),
+		}
+	}
+
+	divergent := 0
+	for _, correction := range corrections {
+		if len(correction.Discrepancies) > 0 {
+			divergent++
+		}
+	}
+
+	return map[string]interface{}{
+		"success":     true,
+		"corrections": corrections,
+		"message":     fmt.Sprintf("%d resultados manuais substituídos, %d com divergências", len(corrections), divergent),
+	}
+}
+
+// GetDataSourceStatus retorna a saúde de cada fonte de sorteios da cadeia de failover
+func (a *App) GetDataSourceStatus() map[string]interface{} {
+	return map[string]interface{}{
+		"success":   true,
+		"sources":   a.dataClient.SourceStatus(),
+		"data_mode": a.dataClient.DataMode(),
+	}
+}
+
+// ===============================
+// BOLÃO
+// ===============================
+
+// CreatePool cria um bolão para agrupar jogos e dividir custos e prêmios por cotas
+func (a *App) CreatePool(request models.CreatePoolRequest) map[string]interface{} {
+	if a.savedGamesDB == nil {
+		return map[string]interface{}{
+			"success": false,
+			"error":   "Banco de dados de jogos salvos não disponível",
+		}
+	}
+
+	pool, err := a.savedGamesDB.CreatePool(request)
+	if err != nil {
+		logs.LogError(logs.CategoryDatabase, "❌ Erro ao criar bolão: %v", err)
+		return map[string]interface{}{
+			"success": false,
+			"error":   fmt.Sprintf("Erro ao criar bolão: %v", err),
+		}
+	}
+
+	return map[string]interface{}{
+		"success": true,
+		"pool":    pool,
+		"message": "Bolão criado com sucesso!",
+	}
+}
+
+// GetPools lista os bolões com participantes, jogos e totais
+func (a *App) GetPools() map[string]interface{} {
+	if a.savedGamesDB == nil {
+		return map[string]interface{}{
+			"success": false,
+			"error":   "Banco de dados de jogos salvos não disponível",
+		}
+	}
+
+	pools, err := a.savedGamesDB.GetPools()
+	if err != nil {
+		logs.LogError(logs.CategoryDatabase, "❌ Erro ao buscar bolões: %v", err)
+		return map[string]interface{}{
+			"success": false,
+			"error":   fmt.Sprintf("Erro ao buscar bolões: %v", err),
+		}
+	}
+
+	return map[string]interface{}{
+		"success": true,
+		"pools":   pools,
+		"total":   len(pools),
+	}
+}
+
+// GetPool busca um bolão com participantes, jogos e totais
+func (a *App) GetPool(poolID string) map[string]interface{} {
+	if a.savedGamesDB == nil {
+		return map[string]interface{}{
+			"success": false,
+			"error":   "Banco de dados de jogos salvos não disponível",
+		}
+	}
+
+	pool, err := a.savedGamesDB.GetPool(poolID)
+	if err != nil {
+		return map[string]interface{}{
+			"success": false,
+			"error":   fmt.Sprintf("Erro ao buscar bolão: %v", err),
+		}
+	}
+
+	return map[string]interface{}{
+		"success": true,
+		"pool":    pool,
+	}
+}
+
+// SetPoolStatus abre ("open") ou fecha ("closed") um bolão
+func (a *App) SetPoolStatus(poolID string, status string) map[string]interface{} {
+	if a.savedGamesDB == nil {
+		return map[string]interface{}{
+			"success": false,
+			"error":   "Banco de dados de jogos salvos não disponível",
+		}
+	}
+
+	if err := a.savedGamesDB.SetPoolStatus(poolID, status); err != nil {
+		logs.LogError(logs.CategoryDatabase, "❌ Erro ao atualizar bolão: %v", err)
+		return map[string]interface{}{
+			"success": false,
+			"error":   fmt.Sprintf("Erro ao atualizar bolão: %v", err),
+		}
+	}
+
+	return map[string]interface{}{
+		"success": true,
+		"message": "Bolão atualizado com sucesso!",
+	}
+}
+
+// AddPoolParticipant inclui um participante com suas cotas no bolão
+func (a *App) AddPoolParticipant(poolID string, name string, quotas int) map[string]interface{} {
+	if a.savedGamesDB == nil {
+		return map[string]interface{}{
+			"success": false,
+			"error":   "Banco de dados de jogos salvos não disponível",
+		}
+	}
+
+	participant, err := a.savedGamesDB.AddPoolParticipant(poolID, name, quotas)
+	if err != nil {
+		logs.LogError(logs.CategoryDatabase, "❌ Erro ao adicionar participante: %v", err)
+		return map[string]interface{}{
+			"success": false,
+			"error":   fmt.Sprintf("Erro ao adicionar participante: %v", err),
+		}
+	}
+
+	return map[string]interface{}{
+		"success":     true,
+		"participant": participant,
+		"message":     fmt.Sprintf("%s entrou no bolão com %d cotas", participant.Name, participant.Quotas),
+	}
+}
+
+// AddGameToPool inclui um jogo salvo no bolão
+func (a *App) AddGameToPool(poolID string, gameID string) map[string]interface{} {
+	if a.savedGamesDB == nil {
+		return map[string]interface{}{
+			"success": false,
+			"error":   "Banco de dados de jogos salvos não disponível",
+		}
+	}
+
+	if err := a.savedGamesDB.AddGameToPool(poolID, gameID); err != nil {
+		logs.LogError(logs.CategoryDatabase, "❌ Erro ao incluir jogo no bolão: %v", err)
+		return map[string]interface{}{
+			"success": false,
+			"error":   fmt.Sprintf("Erro ao incluir jogo no bolão: %v", err),
+		}
+	}
+
+	return map[string]interface{}{
+		"success": true,
+		"message": "Jogo incluído no bolão!",
+	}
+}
+
+// RecordPoolPayment registra o pagamento de cotas de um participante
+func (a *App) RecordPoolPayment(poolID string, participantID string, amount float64) map[string]interface{} {
+	return a.recordPoolEntry(poolID, participantID, models.LedgerPayment, amount, "Pagamento de cotas")
+}
+
+// RecordPoolPayout registra o repasse de prêmio do organizador a um participante
+func (a *App) RecordPoolPayout(poolID string, participantID string, amount float64) map[string]interface{} {
+	return a.recordPoolEntry(poolID, participantID, models.LedgerPayout, amount, "Repasse de prêmio")
+}
+
+// recordPoolEntry lança um pagamento ou repasse no extrato do participante
+func (a *App) recordPoolEntry(poolID, participantID, kind string, amount float64, description string) map[string]interface{} {
+	if a.savedGamesDB == nil {
+		return map[string]interface{}{
+			"success": false,
+			"error":   "Banco de dados de jogos salvos não disponível",
+		}
+	}
+
+	entry, err := a.savedGamesDB.RecordPoolEntry(poolID, participantID, kind, amount, description)
+	if err != nil {
+		logs.LogError(logs.CategoryDatabase, "❌ Erro ao registrar lançamento no bolão: %v", err)
+		return map[string]interface{}{
+			"success": false,
+			"error":   fmt.Sprintf("Erro ao registrar lançamento: %v", err),
+		}
+	}
+
+	return map[string]interface{}{
+		"success": true,
+		"entry":   entry,
+		"message": fmt.Sprintf("%s de R$ %.2f registrado", description, entry.Amount),
+	}
+}
+
+// GetPoolStatements retorna o extrato e o saldo de cada participante do bolão
+func (a *App) GetPoolStatements(poolID string) map[string]interface{} {
+	if a.savedGamesDB == nil {
+		return map[string]interface{}{
+			"success": false,
+			"error":   "Banco de dados de jogos salvos não disponível",
+		}
+	}
+
+	statements, err := a.savedGamesDB.GetPoolStatements(poolID)
+	if err != nil {
+		logs.LogError(logs.CategoryDatabase, "❌ Erro ao montar extrato do bolão: %v", err)
+		return map[string]interface{}{
+			"success": false,
+			"error":   fmt.Sprintf("Erro ao montar extrato do bolão: %v", err),
+		}
+	}
+
+	return map[string]interface{}{
+		"success":    true,
+		"statements": statements,
+	}
+}
+
+// CheckAllPendingResults verifica todos os jogos pendentes
+func (a *App) CheckAllPendingResults() map[string]interface{} {
+	logs.LogDatabase("🔄 Iniciando verificação de todos os jogos pendentes")
+
+	if a.savedGamesDB == nil || a.resultChecker == nil {
+		logs.LogError(logs.CategoryDatabase, "❌ Sistema de verificação não disponível")
+		return map[string]interface{}{
+			"success": false,
+			"error":   "Sistema de verificação não disponível",
+		}
+	}
+
+	// Jogos conferidos com um resultado manual que o oficial corrigiu voltam para a fila
+	if reopened := a.resultChecker.ApplyDrawCorrections(); reopened > 0 {
+		logs.LogDatabase("🔄 %d jogos reabertos por correção de resultado manual", reopened)
+	}
+
 	// Buscar jogos pendentes
 	filter := models.SavedGamesFilter{Status: "pending"}
 	games, err := a.savedGamesDB.GetSavedGames(filter)
@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"context"
+	"lottery-optimizer-gui/internal/data"
+	"lottery-optimizer-gui/internal/lottery"
+	"os"
+	"os/signal"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+var auditRepair bool
+
+// auditCmd confere a integridade dos sorteios guardados no banco local
+var auditCmd = &cobra.Command{
+	Use:   "audit [loteria...]",
+	Short: "🔎 Conferir a integridade dos sorteios guardados",
+	Long: `Confere os sorteios guardados no banco local: concursos ausentes, dezenas em quantidade
+errada, fora da faixa ou repetidas, e datas fora de ordem.
+Sem argumentos, confere todas as loterias.
+
+Com --repair, os concursos com problema são buscados de novo em qualquer fonte disponível.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var types []lottery.LotteryType
+		for _, name := range args {
+			ltype, err := lottery.ParseLotteryType(name)
+			if err != nil {
+				return err
+			}
+			types = append(types, ltype)
+		}
+		if len(types) == 0 {
+			for _, def := range lottery.All() {
+				types = append(types, def.Type)
+			}
+		}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+
+		client := data.NewClient()
+		for _, ltype := range types {
+			color.New(color.FgCyan, color.Bold).Printf("\n🔎 Auditoria de %s\n", ltype)
+
+			var report data.AuditReport
+			var err error
+			if auditRepair {
+				report, err = client.RepairDraws(ctx, ltype)
+			} else {
+				report, err = client.AuditStoredDraws(ltype)
+			}
+			if err != nil {
+				color.Red("❌ Auditoria de %s falhou: %v", ltype, err)
+				continue
+			}
+
+			if !report.HasIssues() {
+				color.Green("✅ %d sorteios sem problemas", report.Checked)
+				continue
+			}
+
+			color.Yellow("⚠️  %d problemas em %d sorteios (%d a %d):", len(report.Issues), report.Checked, report.FirstContest, report.LastContest)
+			for _, issue := range report.Issues {
+				color.Yellow("   • %d: %s", issue.Number, issue.Detail)
+			}
+
+			if auditRepair {
+				color.Green("🔧 %d concursos corrigidos", len(report.Repaired))
+				if len(report.Unrepaired) > 0 {
+					color.Red("❌ Sem correção: %v", report.Unrepaired)
+				}
+				if len(report.Remaining) > 0 {
+					color.Yellow("⚠️  %d problemas restantes", len(report.Remaining))
+				}
+			}
+		}
+		return nil
+	},
+}
+
+func init() {
+	auditCmd.Flags().BoolVar(&auditRepair, "repair", false, "buscar de novo os concursos com problema")
+	rootCmd.AddCommand(auditCmd)
+}
@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"lottery-optimizer-gui/internal/data"
+	"lottery-optimizer-gui/internal/lottery"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// backfillCmd baixa o histórico completo de sorteios para o banco local
+var backfillCmd = &cobra.Command{
+	Use:   "backfill [loteria...]",
+	Short: "📚 Baixar o histórico completo de sorteios",
+	Long: `Baixa todos os concursos, do primeiro ao mais recente, e guarda cada sorteio no banco local.
+Sem argumentos, baixa o histórico de todas as loterias.
+
+O backfill pode ser interrompido (Ctrl+C, queda ou bloqueio 403) e retomado depois:
+concursos já guardados são pulados.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var types []lottery.LotteryType
+		for _, name := range args {
+			ltype, err := lottery.ParseLotteryType(name)
+			if err != nil {
+				return err
+			}
+			types = append(types, ltype)
+		}
+		if len(types) == 0 {
+			for _, def := range lottery.All() {
+				types = append(types, def.Type)
+			}
+		}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+
+		client := data.NewClient()
+		for _, ltype := range types {
+			color.New(color.FgCyan, color.Bold).Printf("\n📚 Histórico de %s\n", ltype)
+
+			progress, err := client.Backfill(ctx, ltype, printBackfillProgress)
+			fmt.Println()
+
+			switch progress.Status {
+			case data.BackfillCompleted:
+				color.Green("✅ %d de %d concursos guardados", progress.Done, progress.Total)
+				if len(progress.FailedContests) > 0 {
+					color.Yellow("⚠️  Concursos com erro: %v", progress.FailedContests)
+				}
+			case data.BackfillBlocked:
+				color.Yellow("⛔ API bloqueada (403) em %d/%d. Rode o backfill de novo mais tarde para continuar.", progress.Done, progress.Total)
+				return nil
+			case data.BackfillCancelled:
+				color.Yellow("⏸️  Interrompido em %d/%d. Rode o backfill de novo para continuar.", progress.Done, progress.Total)
+				return nil
+			default:
+				color.Red("❌ Backfill de %s falhou: %v", ltype, err)
+			}
+		}
+		return nil
+	},
+}
+
+// printBackfillProgress imprime o andamento do backfill na mesma linha do terminal
+func printBackfillProgress(p data.BackfillProgress) {
+	eta := "--"
+	if p.ETASeconds > 0 {
+		eta = p.ETA().Round(time.Second).String()
+	}
+	fmt.Printf("\r⏳ %d/%d (%.1f%%) • ETA %s • erros %d   ", p.Done, p.Total, p.Percent(), eta, p.Errors)
+}
+
+func init() {
+	rootCmd.AddCommand(backfillCmd)
+}
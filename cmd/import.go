@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"lottery-optimizer-gui/internal/data"
+	"lottery-optimizer-gui/internal/lottery"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// importCmd importa a planilha oficial de resultados da CAIXA para o banco local
+var importCmd = &cobra.Command{
+	Use:   "import <loteria> <arquivo>",
+	Short: "📥 Importar a planilha de resultados da CAIXA (XLSX ou HTML)",
+	Long: `Importa o histórico de resultados baixado do site da CAIXA, com faixas de prêmio,
+ganhadores e indicação de acumulado. Útil em máquinas onde a API está bloqueada.
+
+Exemplo:
+  lottery-optimizer import megasena Mega-Sena.xlsx`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ltype, err := lottery.ParseLotteryType(args[0])
+		if err != nil {
+			return err
+		}
+
+		result, err := data.NewClient().ImportResultsFile(ltype, args[1])
+		if err != nil {
+			return err
+		}
+
+		color.Green("✅ %d concursos de %s importados (%d a %d)", result.Imported, ltype, result.FirstContest, result.LastContest)
+		if len(result.Warnings) > 0 {
+			color.Yellow("⚠️  %d avisos:", len(result.Warnings))
+			for _, warning := range result.Warnings {
+				color.Yellow("   • %s", warning)
+			}
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(importCmd)
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"lottery-optimizer-gui/internal/mockcaixa"
+	"net/http"
+	"os"
+	"time"
+)
+
+// Servidor local compatível com a API da CAIXA, com sorteios sintéticos para testar o app sem rede
+//
+// Uso:
+//
+//	go run ./cmd/mockcaixa -addr :8089 -interval 2m -fault-403 0.05
+//
+// e no lottery-optimizer.yaml:
+//
+//	app:
+//	  data_source_url: "http://localhost:8089"
+func main() {
+	addr := flag.String("addr", "localhost:8089", "endereço do servidor")
+	seed := flag.Int64("seed", 1, "semente dos sorteios (a mesma semente gera sempre os mesmos concursos)")
+	start := flag.String("start", "", "data do concurso 1 (AAAA-MM-DD); padrão 2020-01-01, ou -history intervalos atrás com -interval")
+	interval := flag.Duration("interval", 0, "sorteia um concurso a cada intervalo (ex: 2m) em vez de seguir os dias de sorteio de cada loteria")
+	history := flag.Int("history", 100, "concursos já sorteados ao iniciar, com -interval e sem -start")
+	accumulation := flag.Float64("accumulation", 0.6, "chance de a faixa principal acumular (0 a 1)")
+	block := flag.Float64("fault-403", 0, "chance de responder 403 (0 a 1)")
+	serverError := flag.Float64("fault-5xx", 0, "chance de responder 500/502/503 (0 a 1)")
+	latency := flag.Duration("latency", 0, "atraso antes de cada resposta (ex: 300ms)")
+	quiet := flag.Bool("quiet", false, "não registrar cada requisição")
+	flag.Parse()
+
+	schedule := mockcaixa.Schedule{Interval: *interval}
+	switch {
+	case *start != "":
+		parsed, err := time.Parse("2006-01-02", *start)
+		if err != nil {
+			fmt.Printf("❌ Data inválida em -start: %v\n", err)
+			os.Exit(1)
+		}
+		schedule.Start = parsed
+	case *interval > 0:
+		schedule.Start = time.Now().Add(-time.Duration(*history-1) * *interval)
+	default:
+		schedule.Start = time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	}
+
+	server := mockcaixa.NewServer(&mockcaixa.Generator{
+		Seed:             *seed,
+		Schedule:         schedule,
+		AccumulationRate: *accumulation,
+	}, mockcaixa.Faults{
+		BlockRate:       *block,
+		ServerErrorRate: *serverError,
+		Latency:         *latency,
+	})
+	if !*quiet {
+		server.Logf = log.Printf
+	}
+
+	fmt.Printf("🎰 Mock da API da CAIXA em http://%s (semente %d)\n", *addr, *seed)
+	fmt.Printf("💡 Configure app.data_source_url: \"http://%s\" no lottery-optimizer.yaml\n", *addr)
+	if *block > 0 || *serverError > 0 {
+		fmt.Printf("⚠️  Falhas injetadas: %.0f%% 403, %.0f%% 5xx\n", *block*100, *serverError*100)
+	}
+
+	if err := http.ListenAndServe(*addr, server); err != nil {
+		fmt.Printf("❌ Erro no servidor: %v\n", err)
+		os.Exit(1)
+	}
+}
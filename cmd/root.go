@@ -3,8 +3,10 @@ package cmd
 import (
 	"fmt"
 	"lottery-optimizer-gui/internal/config"
+	"lottery-optimizer-gui/internal/lottery"
 	"lottery-optimizer-gui/internal/ui"
 	"os"
+	"strings"
 
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
@@ -19,7 +21,7 @@ var rootCmd = &cobra.Command{
 	Short: "🎰 Otimizador Inteligente de Loterias",
 	Long: color.New(color.FgCyan, color.Bold).Sprint(`
 🎰 LOTTERY OPTIMIZER
-Estratégias Inteligentes para Mega Sena e Lotofácil
+Estratégias Inteligentes para ` + lotteryNames() + `
 
 Usando inteligência artificial avançada para maximizar 
 suas chances de ganhar nas loterias brasileiras!
@@ -32,6 +34,15 @@ Desenvolvido com ❤️  e muita matemática 📊
 	},
 }
 
+// lotteryNames lista as loterias registradas no formato "A, B e C"
+func lotteryNames() string {
+	names := lottery.Names()
+	if len(names) < 2 {
+		return strings.Join(names, "")
+	}
+	return strings.Join(names[:len(names)-1], ", ") + " e " + names[len(names)-1]
+}
+
 // Execute adiciona todos os comandos filhos ao comando raiz e define flags apropriadamente
 func Execute() error {
 	return rootCmd.Execute()
@@ -44,10 +55,12 @@ func init() {
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "arquivo de configuração (padrão: ./lottery-optimizer.yaml ou $HOME/.lottery-optimizer.yaml)")
 	rootCmd.PersistentFlags().Bool("verbose", false, "saída detalhada")
 	rootCmd.PersistentFlags().String("api-key", "", "chave da API do Claude (também pode ser definida via CLAUDE_API_KEY)")
+	rootCmd.PersistentFlags().String("data-mode", "", "modo de dados: live, record (grava as respostas da API) ou replay (usa as respostas gravadas)")
 
 	// Bind flags com viper
 	viper.BindPFlag("verbose", rootCmd.PersistentFlags().Lookup("verbose"))
 	viper.BindPFlag("api-key", rootCmd.PersistentFlags().Lookup("api-key"))
+	viper.BindPFlag("app.data_mode", rootCmd.PersistentFlags().Lookup("data-mode"))
 }
 
 // initConfig lê o arquivo de configuração e variáveis de ambiente
@@ -140,7 +140,7 @@ interface PerformanceMetrics {
     last90Days: PeriodMetrics;
     last365Days: PeriodMetrics;
     monthlyTrends: MonthlyTrend[];
-    lotterySpecific: LotterySpecificMetrics;
+    lotteries: { [lotteryType: string]: LotteryMetrics }; // Indexado pelo tipo registrado (ex: "megasena")
     dailyPerformance: DailyPerformance[];
 }
 
@@ -161,12 +161,8 @@ interface MonthlyTrend {
     growth: number;
 }
 
-interface LotterySpecificMetrics {
-    megaSena: LotteryMetrics;
-    lotofacil: LotteryMetrics;
-}
-
 interface LotteryMetrics {
+    name?: string;
     games: number;
     investment: number;
     winnings: number;
@@ -1341,8 +1337,8 @@ function renderStrategyResult(response: StrategyResponse) {
                         ${strategy.games.map((game: LotteryGame, index: number) => `
                             <div class="game-card">
                                 <div class="game-header">
-                                    <span class="game-icon">${(game.type === 'megasena' || game.type === 'mega-sena') ? '🔥' : '⭐'}</span>
-                                    <span class="game-title">${(game.type === 'megasena' || game.type === 'mega-sena') ? 'Mega-Sena' : 'Lotofácil'} #${index + 1}</span>
+                                    <span class="game-icon">${game.type === 'megasena' ? '🔥' : '⭐'}</span>
+                                    <span class="game-title">${game.type === 'megasena' ? 'Mega-Sena' : 'Lotofácil'} #${index + 1}</span>
                                     <span class="game-cost">R$ ${game.cost.toFixed(2)}</span>
                                 </div>
                                 <div class="game-numbers">
@@ -1730,7 +1726,7 @@ function printStrategy() {
                 ${strategy.games.map((game: LotteryGame, index: number) => `
                     <div class="game-card">
                         <div class="game-header">
-                            <span class="game-title">${(game.type === 'megasena' || game.type === 'mega-sena') ? '🔥 Mega-Sena' : '⭐ Lotofácil'} #${index + 1}</span>
+                            <span class="game-title">${game.type === 'megasena' ? '🔥 Mega-Sena' : '⭐ Lotofácil'} #${index + 1}</span>
                             <span class="game-cost">R$ ${game.cost.toFixed(2)}</span>
                         </div>
                         <div class="game-numbers">
@@ -1864,7 +1860,7 @@ function closeModal() {
 async function confirmSaveGame(lotteryType: string, numbers: number[], expectedDraw: string, contestNumber: number) {
     try {
         const request = new models.SaveGameRequest({
-            lottery_type: (lotteryType === 'megasena' || lotteryType === 'mega-sena') ? 'mega-sena' : 'lotofacil',
+            lottery_type: (lotteryType === 'megasena' || lotteryType === 'mega-sena') ? 'megasena' : 'lotofacil',
             numbers: numbers,
             expected_draw: expectedDraw.split('/').reverse().join('-'), // Converter DD/MM/YYYY para YYYY-MM-DD
             contest_number: contestNumber
@@ -1963,7 +1959,7 @@ async function renderSavedGamesScreen() {
                         <div class="filters-grid">
                             <select id="lotteryFilter" onchange="filterSavedGames()">
                                 <option value="">Todas as Loterias</option>
-                                <option value="mega-sena">Mega-Sena</option>
+                                <option value="megasena">Mega-Sena</option>
                                 <option value="lotofacil">Lotofácil</option>
                             </select>
                             <select id="statusFilter" onchange="filterSavedGames()">
@@ -2036,8 +2032,8 @@ async function renderSavedGamesScreen() {
 // Renderizar lista de jogos salvos
 function renderSavedGamesList(savedGames: SavedGame[]): string {
     return savedGames.map(game => {
-        const lotteryIcon = game.lottery_type === 'mega-sena' ? '🔥' : '⭐';
-        const lotteryName = game.lottery_type === 'mega-sena' ? 'Mega-Sena' : 'Lotofácil';
+        const lotteryIcon = game.lottery_type === 'megasena' ? '🔥' : '⭐';
+        const lotteryName = game.lottery_type === 'megasena' ? 'Mega-Sena' : 'Lotofácil';
         const statusClass = getStatusClass(game.status);
         const statusText = getStatusText(game.status);
         const statusIcon = getStatusIcon(game.status);
@@ -2339,7 +2335,7 @@ async function deleteSavedGame(gameId: string) {
 
 // Gerar estatísticas por loteria
 function generateLotteryStats(strategy: Strategy): string {
-    const megaSenaGames = strategy.games.filter(game => (game.type === 'megasena' || game.type === 'mega-sena'));
+    const megaSenaGames = strategy.games.filter(game => game.type === 'megasena');
     const lotofacilGames = strategy.games.filter(game => game.type === 'lotofacil');
     
     let html = '<div class="lottery-stats-grid">';
@@ -2414,7 +2410,7 @@ function generateLotteryStats(strategy: Strategy): string {
 
 // Calcular retorno esperado estimado
 function calculateExpectedReturn(strategy: Strategy): string {
-    const megaSenaGames = strategy.games.filter(game => (game.type === 'megasena' || game.type === 'mega-sena'));
+    const megaSenaGames = strategy.games.filter(game => game.type === 'megasena');
     const lotofacilGames = strategy.games.filter(game => game.type === 'lotofacil');
     
     // Estimativas conservadoras baseadas em estatísticas históricas
@@ -2448,7 +2444,7 @@ function getAppliedSystems(strategy: Strategy): string {
     // Verificar se há jogos com mais números (sistemas de redução)
     const hasExtendedGames = strategy.games.some(game => 
         (game.type === 'lotofacil' && game.numbers.length > 15) ||
-        ((game.type === 'megasena' || game.type === 'mega-sena') && game.numbers.length > 6)
+        (game.type === 'megasena' && game.numbers.length > 6)
     );
     
     if (hasExtendedGames) {
@@ -2770,7 +2766,7 @@ function calculateManualMetrics(gamesWithResults: any[]): any {
     const summary = calculateManualSummary(gamesWithResults);
     
     // Análise por loteria
-    const megaSenaGames = gamesWithResults.filter(g => g.lottery_type === 'mega-sena');
+    const megaSenaGames = gamesWithResults.filter(g => g.lottery_type === 'megasena');
     const lotofacilGames = gamesWithResults.filter(g => g.lottery_type === 'lotofacil');
     
     const megaSenaMetrics = calculateLotteryMetrics(megaSenaGames);
@@ -2792,9 +2788,9 @@ function calculateManualMetrics(gamesWithResults: any[]): any {
         last90Days: summary.last30Days, // Simplificado
         last365Days: summary.last30Days, // Simplificado
         monthlyTrends: [], // Simplificado
-        lotterySpecific: {
-            megaSena: megaSenaMetrics,
-            lotofacil: lotofacilMetrics
+        lotteries: {
+            megasena: { name: 'Mega-Sena', ...megaSenaMetrics },
+            lotofacil: { name: 'Lotofácil', ...lotofacilMetrics }
         },
         dailyPerformance: [] // Simplificado
     };
@@ -3671,6 +3667,39 @@ async function renderDetailedAnalytics() {
     }
 }
 
+// Card de performance de uma loteria na análise detalhada
+function renderLotteryMetricsCard(slug: string, lottery: LotteryMetrics): string {
+    return `
+                        <div style="border: 1px solid #e5e7eb; border-radius: 0.5rem; padding: 1rem;">
+                            <h4 style="color: var(--accent-primary); margin-bottom: 1rem;">${lottery.name || slug}</h4>
+                            <div style="space-y: 0.5rem;">
+                                <div style="display: flex; justify-content: space-between;">
+                                    <span>Jogos:</span>
+                                    <strong>${lottery.games}</strong>
+                                </div>
+                                <div style="display: flex; justify-content: space-between;">
+                                    <span>Investimento:</span>
+                                    <strong>R$ ${lottery.investment.toFixed(2)}</strong>
+                                </div>
+                                <div style="display: flex; justify-content: space-between;">
+                                    <span>Retorno:</span>
+                                    <strong>R$ ${lottery.winnings.toFixed(2)}</strong>
+                                </div>
+                                <div style="display: flex; justify-content: space-between;">
+                                    <span>ROI:</span>
+                                    <strong style="color: ${lottery.roi >= 0 ? '#059669' : '#dc2626'};">
+                                        ${lottery.roi.toFixed(2)}%
+                                    </strong>
+                                </div>
+                                <div style="display: flex; justify-content: space-between;">
+                                    <span>Taxa de Acerto:</span>
+                                    <strong>${lottery.winRate.toFixed(1)}%</strong>
+                                </div>
+                            </div>
+                        </div>
+    `;
+}
+
 function renderDetailedAnalyticsContent(metrics: PerformanceMetrics) {
     const app = document.getElementById('app')!;
     
@@ -3855,61 +3884,10 @@ function renderDetailedAnalyticsContent(metrics: PerformanceMetrics) {
                 <div class="feature-card" style="margin-bottom: 2rem;">
                     <h3 style="margin-bottom: 1rem;">🎰 Performance por Loteria</h3>
                     <div style="display: grid; grid-template-columns: repeat(auto-fit, minmax(300px, 1fr)); gap: 1rem;">
-                        <div style="border: 1px solid #e5e7eb; border-radius: 0.5rem; padding: 1rem;">
-                            <h4 style="color: #dc2626; margin-bottom: 1rem;">🔥 Mega-Sena</h4>
-                            <div style="space-y: 0.5rem;">
-                                <div style="display: flex; justify-content: space-between;">
-                                    <span>Jogos:</span>
-                                    <strong>${metrics.lotterySpecific.megaSena.games}</strong>
-                                </div>
-                                <div style="display: flex; justify-content: space-between;">
-                                    <span>Investimento:</span>
-                                    <strong>R$ ${metrics.lotterySpecific.megaSena.investment.toFixed(2)}</strong>
-                                </div>
-                                <div style="display: flex; justify-content: space-between;">
-                                    <span>Retorno:</span>
-                                    <strong>R$ ${metrics.lotterySpecific.megaSena.winnings.toFixed(2)}</strong>
-                                </div>
-                                <div style="display: flex; justify-content: space-between;">
-                                    <span>ROI:</span>
-                                    <strong style="color: ${metrics.lotterySpecific.megaSena.roi >= 0 ? '#059669' : '#dc2626'};">
-                                        ${metrics.lotterySpecific.megaSena.roi.toFixed(2)}%
-                                    </strong>
-                                </div>
-                                <div style="display: flex; justify-content: space-between;">
-                                    <span>Taxa de Acerto:</span>
-                                    <strong>${metrics.lotterySpecific.megaSena.winRate.toFixed(1)}%</strong>
-                                </div>
-                            </div>
-                        </div>
-
-                        <div style="border: 1px solid #e5e7eb; border-radius: 0.5rem; padding: 1rem;">
-                            <h4 style="color: #f59e0b; margin-bottom: 1rem;">⭐ Lotofácil</h4>
-                            <div style="space-y: 0.5rem;">
-                                <div style="display: flex; justify-content: space-between;">
-                                    <span>Jogos:</span>
-                                    <strong>${metrics.lotterySpecific.lotofacil.games}</strong>
-                                </div>
-                                <div style="display: flex; justify-content: space-between;">
-                                    <span>Investimento:</span>
-                                    <strong>R$ ${metrics.lotterySpecific.lotofacil.investment.toFixed(2)}</strong>
-                                </div>
-                                <div style="display: flex; justify-content: space-between;">
-                                    <span>Retorno:</span>
-                                    <strong>R$ ${metrics.lotterySpecific.lotofacil.winnings.toFixed(2)}</strong>
-                                </div>
-                                <div style="display: flex; justify-content: space-between;">
-                                    <span>ROI:</span>
-                                    <strong style="color: ${metrics.lotterySpecific.lotofacil.roi >= 0 ? '#059669' : '#dc2626'};">
-                                        ${metrics.lotterySpecific.lotofacil.roi.toFixed(2)}%
-                                    </strong>
-                                </div>
-                                <div style="display: flex; justify-content: space-between;">
-                                    <span>Taxa de Acerto:</span>
-                                    <strong>${metrics.lotterySpecific.lotofacil.winRate.toFixed(1)}%</strong>
-                                </div>
-                            </div>
-                        </div>
+                        ${Object.entries(metrics.lotteries || {})
+                            .filter(([, lottery]) => lottery.games > 0)
+                            .map(([slug, lottery]) => renderLotteryMetricsCard(slug, lottery))
+                            .join('') || '<p>Nenhum jogo conferido ainda.</p>'}
                     </div>
                 </div>
 
@@ -4195,7 +4173,7 @@ function showAddManualGameModal() {
                         <h4>🎯 Tipo de Loteria</h4>
                         <div class="lottery-options">
                             <label class="lottery-option">
-                                <input type="radio" name="lotteryType" value="mega-sena" onchange="updateNumberLimits()" checked>
+                                <input type="radio" name="lotteryType" value="megasena" onchange="updateNumberLimits()" checked>
                                 <div class="option-card">
                                     <span class="option-icon">🔥</span>
                                     <div class="option-content">
@@ -4287,7 +4265,7 @@ function updateNumberLimits() {
     const lotteryType = (document.querySelector('input[name="lotteryType"]:checked') as HTMLInputElement)?.value;
     const limitsText = document.getElementById('numberLimitsText')!;
     
-    if (lotteryType === 'mega-sena') {
+    if (lotteryType === 'megasena') {
         limitsText.textContent = 'Selecione entre 6 e 15 números de 1 a 60';
         createNumbersGrid(1, 60);
     } else if (lotteryType === 'lotofacil') {
@@ -4396,7 +4374,7 @@ function validateNumberSelection(lotteryType: string, count: number): boolean {
     let isValid = true;
     let errorMessage = '';
     
-    if (lotteryType === 'mega-sena') {
+    if (lotteryType === 'megasena') {
         if (count < 6) {
             isValid = false;
             errorMessage = 'Mega-Sena precisa de pelo menos 6 números';
@@ -4775,7 +4753,7 @@ function calculateFavoriteNumbers(games: any[]): any {
 function analyzePlayingPatterns(games: any[]): any {
     try {
         const totalGames = games.length;
-        const megaSenaGames = games.filter(g => g.lottery_type === 'mega-sena').length;
+        const megaSenaGames = games.filter(g => g.lottery_type === 'megasena').length;
         const lotofacilGames = games.filter(g => g.lottery_type === 'lotofacil').length;
         
         const preferredGame = megaSenaGames > lotofacilGames ? 'Mega-Sena' : 'Lotofácil';
@@ -5097,7 +5075,7 @@ function generateHeatmapData(games: any[]): any {
         games.forEach(game => {
             if (game.numbers && Array.isArray(game.numbers)) {
                 game.numbers.forEach((num: number) => {
-                    if (game.lottery_type === 'mega-sena') {
+                    if (game.lottery_type === 'megasena') {
                         megaSenaNumbers[num] = (megaSenaNumbers[num] || 0) + 1;
                     } else if (game.lottery_type === 'lotofacil') {
                         lotofacilNumbers[num] = (lotofacilNumbers[num] || 0) + 1;
@@ -5514,7 +5492,7 @@ function getROIInsight(prediction: number, confidence: string): string {
 
 // Função auxiliar para calcular custo do jogo
 function getCostForGame(lotteryType: string, numbersCount: number): number {
-    if (lotteryType === 'mega-sena') {
+    if (lotteryType === 'megasena') {
         // Preços oficiais Mega-Sena
         const prices: { [key: number]: number } = {
             6: 5.00, 7: 35.00, 8: 140.00, 9: 420.00, 10: 1050.00,
@@ -207,14 +207,10 @@ func (c *ClaudeClient) AnalyzeStrategy(request lottery.AnalysisRequest) (*lotter
 		for i := range analysisResp.Strategy.Games {
 			game := &analysisResp.Strategy.Games[i]
 
-			// Converter tipos incorretos que o Claude possa retornar
-			switch string(game.Type) {
-			case "mega-sena", "megasena", "Mega-Sena", "MEGASENA":
-				game.Type = lottery.MegaSena
-				logs.LogAI("🔧 CORRIGINDO TIPO: '%s' -> 'megasena'", string(game.Type))
-			case "loto-facil", "lotofacil", "Lotofácil", "LOTOFACIL":
-				game.Type = lottery.Lotofacil
-				logs.LogAI("🔧 CORRIGINDO TIPO: '%s' -> 'lotofacil'", string(game.Type))
+			// Converter tipos incorretos que o Claude possa retornar (ex: "Mega-Sena" -> "megasena")
+			if def, ok := lottery.Lookup(string(game.Type)); ok && def.Type != game.Type {
+				logs.LogAI("🔧 CORRIGINDO TIPO: '%s' -> '%s'", string(game.Type), string(def.Type))
+				game.Type = def.Type
 			}
 		}
 
@@ -222,7 +218,13 @@ func (c *ClaudeClient) AnalyzeStrategy(request lottery.AnalysisRequest) (*lotter
 		totalCostRecalculated := 0.0
 		for i := range analysisResp.Strategy.Games {
 			game := &analysisResp.Strategy.Games[i]
-			correctCost := lottery.CalculateGameCost(game.Type, len(game.Numbers))
+			correctCost, err := lottery.GameCost(*game)
+			if err != nil {
+				// Quantidades inválidas são corrigidas ou descartadas na validação da estratégia
+				logs.LogAI("⚠️ Custo não recalculado para %s: %v", game.Type, err)
+				totalCostRecalculated += game.Cost
+				continue
+			}
 
 			if game.Cost != correctCost {
 				logs.LogAI("🔧 CORRIGINDO CUSTO: %s com %d números - Claude retornou R$ %.2f, correto é R$ %.2f",
@@ -359,8 +361,9 @@ func (c *ClaudeClient) AnalyzeStrategy(request lottery.AnalysisRequest) (*lotter
 						newTotalCost := 0.0
 						for i := range newAnalysisResp.Strategy.Games {
 							game := &newAnalysisResp.Strategy.Games[i]
-							correctCost := lottery.CalculateGameCost(game.Type, len(game.Numbers))
-							game.Cost = correctCost
+							if correctCost, err := lottery.GameCost(*game); err == nil {
+								game.Cost = correctCost
+							}
 							newTotalCost += game.Cost
 						}
 						newAnalysisResp.Strategy.TotalCost = newTotalCost
@@ -454,7 +457,11 @@ func (c *ClaudeClient) generateFallbackStrategy(request lottery.AnalysisRequest)
 			for i := 0; i < gamesCount && totalCost+bestOption.cost <= budget; i++ {
 				// Gerar números baseado na quantidade otimizada
 				numbers := generateOptimizedLotofacilNumbers(bestOption.numbers, i)
-				cost := lottery.CalculateGameCost(lottery.Lotofacil, len(numbers))
+				cost, err := lottery.CalculateGameCost(lottery.Lotofacil, len(numbers))
+				if err != nil {
+					logs.LogAI("⚠️ Jogo de Lotofácil descartado: %v", err)
+					break
+				}
 
 				games = append(games, lottery.Game{
 					Type:    lottery.Lotofacil,
@@ -477,7 +484,11 @@ func (c *ClaudeClient) generateFallbackStrategy(request lottery.AnalysisRequest)
 
 				for i := 0; i < megaGamesCount && totalCost+5 <= budget; i++ {
 					numbers := generateMegaSenaNumbers(i)
-					cost := lottery.CalculateGameCost(lottery.MegaSena, len(numbers))
+					cost, err := lottery.CalculateGameCost(lottery.MegaSena, len(numbers))
+					if err != nil {
+						logs.LogAI("⚠️ Jogo de Mega-Sena descartado: %v", err)
+						break
+					}
 
 					games = append(games, lottery.Game{
 						Type:    lottery.MegaSena,
@@ -773,8 +784,7 @@ Você é um MATEMÁTICO ESPECIALISTA MUNDIAL em loterias, combinatória avançad
 %s
 
 === PREÇOS OFICIAIS CAIXA (EXATOS) ===
-MEGA-SENA: 6→R$5,00 | 7→R$35,00 | 8→R$140,00 | 9→R$420,00 | 10→R$1.050,00 | 11→R$2.310,00 | 12→R$4.620,00
-LOTOFÁCIL: 15→R$3,00 | 16→R$48,00 | 17→R$408,00 | 18→R$2.448,00 | 19→R$11.628,00 | 20→R$46.512,00
+%s
 
 === ANÁLISE DE VALOR ESPERADO PROFISSIONAL ===
 LOTOFÁCIL VALOR ESPERADO COMPLETO (incluindo prêmios secundários):
@@ -803,8 +813,7 @@ MEGA-SENA - SISTEMAS DE GARANTIA:
 === FILTROS MATEMÁTICOS AVANÇADOS (OBRIGATÓRIOS) ===
 
 🚨 NÚMEROS MÍNIMOS OBRIGATÓRIOS (CRÍTICO):
-• LOTOFÁCIL: SEMPRE 15, 16, 17, 18, 19 ou 20 números (NUNCA MENOS QUE 15!)
-• MEGA-SENA: SEMPRE 6, 7, 8, 9, 10, 11 ou 12 números (NUNCA MENOS QUE 6!)
+%s
 
 1. **FILTRO DE SOMA INTELIGENTE:**
    - Lotofácil: somas entre 170-210 (80%% dos sorteios históricos)
@@ -926,7 +935,15 @@ RETORNE APENAS JSON VÁLIDO (sem markdown):
 10. 🚨 UTILIZAÇÃO MÍNIMA 90%% DO ORÇAMENTO - OBRIGATÓRIO!
 
 Use SOMENTE os dados estatísticos fornecidos + filtros matemáticos avançados. Esta é a estratégia de ESPECIALISTAS MUNDIAIS!`,
-		budget, budget*0.90, budget*0.98, budget, budget*0.90, budget, budget, statisticalAnalysis, budget, budget*0.90, len(request.Draws))
+		budget, budget*0.90, budget*0.98, budget, budget*0.90, budget, budget, statisticalAnalysis, promptPricing(), promptRules(), budget, budget*0.90, len(request.Draws))
+
+	// Palpites extras escolhidos pelo usuário (Time do Coração, Mês da Sorte)
+	if len(request.Preferences.ExtraPicks) > 0 {
+		prompt += "\n\n🎯 PALPITES EXTRAS DO USUÁRIO (use exatamente no campo \"extraPick\"):\n"
+		for ltype, pick := range request.Preferences.ExtraPicks {
+			prompt += fmt.Sprintf("• %s: %s = %s\n", ltype, lottery.GetRules(ltype).ExtraPickName, pick)
+		}
+	}
 
 	return prompt
 }
@@ -991,11 +1008,37 @@ func (c *ClaudeClient) analyzeHistoricalData(draws []lottery.Draw, lotteryTypes
 	// Separar dados por tipo de loteria
 	megaDraws := []lottery.Draw{}
 	lotoDraws := []lottery.Draw{}
+	quinaDraws := []lottery.Draw{}
+	lotomaniaDraws := []lottery.Draw{}
+	duplaSenaDraws := []lottery.Draw{}
+	maisMilionariaDraws := []lottery.Draw{}
+	timemaniaDraws := []lottery.Draw{}
+	diaDeSorteDraws := []lottery.Draw{}
+	superSeteDraws := []lottery.Draw{}
+	lotecaDraws := []lottery.Draw{}
 
 	for _, draw := range draws {
 		numbers := draw.Numbers.ToIntSlice()
-		if len(numbers) == 6 { // Mega-Sena
+		if len(draw.Matches) > 0 { // Loteca (partidas de futebol, sem dezenas)
+			lotecaDraws = append(lotecaDraws, draw)
+		} else if len(draw.SecondNumbers) > 0 { // Dupla Sena (dois sorteios por concurso)
+			duplaSenaDraws = append(duplaSenaDraws, draw)
+		} else if len(draw.Secondary) > 0 { // +Milionária (dezenas + trevos)
+			maisMilionariaDraws = append(maisMilionariaDraws, draw)
+		} else if draw.ExtraPick != "" { // Dia de Sorte (mês) ou Timemania (time)
+			if _, err := lottery.NormalizeExtraPick(lottery.DiaDeSorte, draw.ExtraPick); err == nil {
+				diaDeSorteDraws = append(diaDeSorteDraws, draw)
+			} else {
+				timemaniaDraws = append(timemaniaDraws, draw)
+			}
+		} else if len(numbers) == 7 { // Super Sete (um dígito por coluna)
+			superSeteDraws = append(superSeteDraws, draw)
+		} else if len(numbers) == 6 { // Mega-Sena
 			megaDraws = append(megaDraws, draw)
+		} else if len(numbers) == 5 { // Quina
+			quinaDraws = append(quinaDraws, draw)
+		} else if len(numbers) == 20 { // Lotomania
+			lotomaniaDraws = append(lotomaniaDraws, draw)
 		} else if len(numbers) >= 15 { // Lotofácil
 			lotoDraws = append(lotoDraws, draw)
 		}
@@ -1045,6 +1088,142 @@ func (c *ClaudeClient) analyzeHistoricalData(draws []lottery.Draw, lotteryTypes
 		analysis.WriteString("\n")
 	}
 
+	// Analisar Quina
+	if len(quinaDraws) > 0 {
+		analysis.WriteString("🎯 QUINA - FREQUÊNCIAS REAIS:\n")
+		quinaFreq := calculateNumberFrequency(quinaDraws, 80)
+		quinaHot, quinaCold := getHotColdNumbers(quinaFreq, 10)
+		quinaSums := calculateSumDistribution(quinaDraws)
+		quinaPairs := calculatePairImparDistribution(quinaDraws)
+		quinaSumMin, quinaSumMax := getMostCommonSumRange(quinaSums)
+
+		analysis.WriteString(fmt.Sprintf("• Sorteios analisados: %d\n", len(quinaDraws)))
+		analysis.WriteString(fmt.Sprintf("• Números MAIS frequentes: %v\n", quinaHot))
+		analysis.WriteString(fmt.Sprintf("• Números MENOS frequentes: %v\n", quinaCold))
+		analysis.WriteString(fmt.Sprintf("• Soma mais comum: %d-%d\n", quinaSumMin, quinaSumMax))
+		analysis.WriteString(fmt.Sprintf("• Distribuição Par/Ímpar: %.1f%% pares\n", quinaPairs))
+		analysis.WriteString(fmt.Sprintf("• Faixas por frequência:\n"))
+		analysis.WriteString(fmt.Sprintf("  - 1-20: %v\n", getNumbersInRange(quinaHot, 1, 20)))
+		analysis.WriteString(fmt.Sprintf("  - 21-40: %v\n", getNumbersInRange(quinaHot, 21, 40)))
+		analysis.WriteString(fmt.Sprintf("  - 41-60: %v\n", getNumbersInRange(quinaHot, 41, 60)))
+		analysis.WriteString(fmt.Sprintf("  - 61-80: %v\n", getNumbersInRange(quinaHot, 61, 80)))
+		analysis.WriteString("\n")
+	}
+
+	// Analisar Lotomania
+	if len(lotomaniaDraws) > 0 {
+		analysis.WriteString("🎲 LOTOMANIA - FREQUÊNCIAS REAIS:\n")
+		lotomaniaFreq := calculateNumberFrequency(lotomaniaDraws, 100)
+		lotomaniaHot, lotomaniaCold := getHotColdNumbers(lotomaniaFreq, 20)
+		lotomaniaPairs := calculatePairImparDistribution(lotomaniaDraws)
+
+		analysis.WriteString(fmt.Sprintf("• Sorteios analisados: %d\n", len(lotomaniaDraws)))
+		analysis.WriteString(fmt.Sprintf("• Números MAIS frequentes: %v\n", lotomaniaHot))
+		analysis.WriteString(fmt.Sprintf("• Números MENOS frequentes: %v\n", lotomaniaCold))
+		analysis.WriteString(fmt.Sprintf("• Distribuição Par/Ímpar: %.1f%% pares\n", lotomaniaPairs))
+		analysis.WriteString(fmt.Sprintf("• Faixas por frequência:\n"))
+		analysis.WriteString(fmt.Sprintf("  - 1-25: %v\n", getNumbersInRange(lotomaniaHot, 1, 25)))
+		analysis.WriteString(fmt.Sprintf("  - 26-50: %v\n", getNumbersInRange(lotomaniaHot, 26, 50)))
+		analysis.WriteString(fmt.Sprintf("  - 51-75: %v\n", getNumbersInRange(lotomaniaHot, 51, 75)))
+		analysis.WriteString(fmt.Sprintf("  - 76-100: %v\n", getNumbersInRange(lotomaniaHot, 76, 100)))
+		analysis.WriteString("\n")
+	}
+
+	// Analisar Dupla Sena (os dois sorteios de cada concurso contam na frequência)
+	if len(duplaSenaDraws) > 0 {
+		var duplaSenaSets []lottery.Draw
+		for _, draw := range duplaSenaDraws {
+			duplaSenaSets = append(duplaSenaSets, draw)
+			duplaSenaSets = append(duplaSenaSets, lottery.Draw{Number: draw.Number, Date: draw.Date, Numbers: draw.SecondNumbers})
+		}
+
+		analysis.WriteString("🎯🎯 DUPLA SENA - FREQUÊNCIAS REAIS:\n")
+		duplaFreq := calculateNumberFrequency(duplaSenaSets, 50)
+		duplaHot, duplaCold := getHotColdNumbers(duplaFreq, 10)
+		duplaSums := calculateSumDistribution(duplaSenaSets)
+		duplaPairs := calculatePairImparDistribution(duplaSenaSets)
+		duplaSumMin, duplaSumMax := getMostCommonSumRange(duplaSums)
+
+		analysis.WriteString(fmt.Sprintf("• Concursos analisados: %d (%d sorteios)\n", len(duplaSenaDraws), len(duplaSenaSets)))
+		analysis.WriteString(fmt.Sprintf("• Números MAIS frequentes: %v\n", duplaHot))
+		analysis.WriteString(fmt.Sprintf("• Números MENOS frequentes: %v\n", duplaCold))
+		analysis.WriteString(fmt.Sprintf("• Soma mais comum: %d-%d\n", duplaSumMin, duplaSumMax))
+		analysis.WriteString(fmt.Sprintf("• Distribuição Par/Ímpar: %.1f%% pares\n", duplaPairs))
+		analysis.WriteString("\n")
+	}
+
+	// Analisar +Milionária
+	if len(maisMilionariaDraws) > 0 {
+		var trevoDraws []lottery.Draw
+		for _, draw := range maisMilionariaDraws {
+			trevoDraws = append(trevoDraws, lottery.Draw{Number: draw.Number, Date: draw.Date, Numbers: draw.Secondary})
+		}
+
+		analysis.WriteString("🍀💰 +MILIONÁRIA - FREQUÊNCIAS REAIS:\n")
+		maisFreq := calculateNumberFrequency(maisMilionariaDraws, 50)
+		maisHot, maisCold := getHotColdNumbers(maisFreq, 10)
+		trevoFreq := calculateNumberFrequency(trevoDraws, 6)
+		trevoHot, _ := getHotColdNumbers(trevoFreq, 3)
+		maisPairs := calculatePairImparDistribution(maisMilionariaDraws)
+
+		analysis.WriteString(fmt.Sprintf("• Sorteios analisados: %d\n", len(maisMilionariaDraws)))
+		analysis.WriteString(fmt.Sprintf("• Números MAIS frequentes: %v\n", maisHot))
+		analysis.WriteString(fmt.Sprintf("• Números MENOS frequentes: %v\n", maisCold))
+		analysis.WriteString(fmt.Sprintf("• Trevos MAIS frequentes: %v\n", trevoHot))
+		analysis.WriteString(fmt.Sprintf("• Distribuição Par/Ímpar: %.1f%% pares\n", maisPairs))
+		analysis.WriteString("\n")
+	}
+
+	// Analisar Timemania
+	if len(timemaniaDraws) > 0 {
+		analysis.WriteString("⚽ TIMEMANIA - FREQUÊNCIAS REAIS:\n")
+		timeFreq := calculateNumberFrequency(timemaniaDraws, 80)
+		timeHot, timeCold := getHotColdNumbers(timeFreq, 10)
+
+		analysis.WriteString(fmt.Sprintf("• Sorteios analisados: %d\n", len(timemaniaDraws)))
+		analysis.WriteString(fmt.Sprintf("• Números MAIS frequentes: %v\n", timeHot))
+		analysis.WriteString(fmt.Sprintf("• Números MENOS frequentes: %v\n", timeCold))
+		analysis.WriteString(fmt.Sprintf("• Times do Coração mais sorteados: %v\n", topExtraPicks(timemaniaDraws, 5)))
+		analysis.WriteString("\n")
+	}
+
+	// Analisar Dia de Sorte
+	if len(diaDeSorteDraws) > 0 {
+		analysis.WriteString("📅 DIA DE SORTE - FREQUÊNCIAS REAIS:\n")
+		diaFreq := calculateNumberFrequency(diaDeSorteDraws, 31)
+		diaHot, diaCold := getHotColdNumbers(diaFreq, 8)
+		diaPairs := calculatePairImparDistribution(diaDeSorteDraws)
+
+		analysis.WriteString(fmt.Sprintf("• Sorteios analisados: %d\n", len(diaDeSorteDraws)))
+		analysis.WriteString(fmt.Sprintf("• Números MAIS frequentes: %v\n", diaHot))
+		analysis.WriteString(fmt.Sprintf("• Números MENOS frequentes: %v\n", diaCold))
+		analysis.WriteString(fmt.Sprintf("• Distribuição Par/Ímpar: %.1f%% pares\n", diaPairs))
+		analysis.WriteString(fmt.Sprintf("• Meses da Sorte mais sorteados: %v\n", topExtraPicks(diaDeSorteDraws, 3)))
+		analysis.WriteString("\n")
+	}
+
+	// Analisar Super Sete (frequência por coluna, já que cada coluna é sorteada separadamente)
+	if len(superSeteDraws) > 0 {
+		analysis.WriteString("🔢 SUPER SETE - FREQUÊNCIAS REAIS POR COLUNA:\n")
+		analysis.WriteString(fmt.Sprintf("• Sorteios analisados: %d\n", len(superSeteDraws)))
+		for i, digits := range topColumnDigits(superSeteDraws, 7, 3) {
+			analysis.WriteString(fmt.Sprintf("  - Coluna %d: dígitos MAIS frequentes %v\n", i+1, digits))
+		}
+		analysis.WriteString("\n")
+	}
+
+	// Analisar Loteca (distribuição de resultados das partidas)
+	if len(lotecaDraws) > 0 {
+		home, tie, away := calculateOutcomeDistribution(lotecaDraws)
+
+		analysis.WriteString("⚽ LOTECA - RESULTADOS REAIS:\n")
+		analysis.WriteString(fmt.Sprintf("• Concursos analisados: %d\n", len(lotecaDraws)))
+		analysis.WriteString(fmt.Sprintf("• Vitórias da coluna 1 (mandante): %.1f%%\n", home))
+		analysis.WriteString(fmt.Sprintf("• Empates (coluna do meio): %.1f%%\n", tie))
+		analysis.WriteString(fmt.Sprintf("• Vitórias da coluna 2 (visitante): %.1f%%\n", away))
+		analysis.WriteString("\n")
+	}
+
 	analysis.WriteString("⚡ OTIMIZAÇÃO MATEMÁTICA:\n")
 	analysis.WriteString("• Lotofácil 16 números = 16 combinações por R$48 = 0.33 comb/real\n")
 	analysis.WriteString("• Mega-Sena 8 números = 28 combinações por R$140 = 0.20 comb/real\n")
@@ -1054,6 +1233,89 @@ func (c *ClaudeClient) analyzeHistoricalData(draws []lottery.Draw, lotteryTypes
 	return analysis.String()
 }
 
+// topExtraPicks retorna os palpites extras (times ou meses) mais sorteados
+func topExtraPicks(draws []lottery.Draw, limit int) []string {
+	counts := make(map[string]int)
+	for _, draw := range draws {
+		if draw.ExtraPick != "" {
+			counts[draw.ExtraPick]++
+		}
+	}
+
+	picks := make([]string, 0, len(counts))
+	for pick := range counts {
+		picks = append(picks, pick)
+	}
+	sort.Slice(picks, func(i, j int) bool {
+		if counts[picks[i]] != counts[picks[j]] {
+			return counts[picks[i]] > counts[picks[j]]
+		}
+		return picks[i] < picks[j]
+	})
+
+	if len(picks) > limit {
+		picks = picks[:limit]
+	}
+	return picks
+}
+
+// topColumnDigits retorna os dígitos mais sorteados em cada coluna de loterias posicionais
+func topColumnDigits(draws []lottery.Draw, columns, limit int) [][]int {
+	result := make([][]int, columns)
+
+	for col := 0; col < columns; col++ {
+		frequency := make(map[int]int)
+		for _, draw := range draws {
+			if col < len(draw.Numbers) {
+				frequency[draw.Numbers[col]]++
+			}
+		}
+
+		digits := make([]int, 0, len(frequency))
+		for digit := range frequency {
+			digits = append(digits, digit)
+		}
+		sort.Slice(digits, func(i, j int) bool {
+			if frequency[digits[i]] != frequency[digits[j]] {
+				return frequency[digits[i]] > frequency[digits[j]]
+			}
+			return digits[i] < digits[j]
+		})
+
+		if len(digits) > limit {
+			digits = digits[:limit]
+		}
+		result[col] = digits
+	}
+
+	return result
+}
+
+// calculateOutcomeDistribution calcula o percentual de vitórias do mandante, empates e vitórias do visitante
+func calculateOutcomeDistribution(draws []lottery.Draw) (float64, float64, float64) {
+	counts := make(map[string]int)
+	total := 0
+
+	for _, draw := range draws {
+		for _, outcome := range draw.MatchOutcomes() {
+			if outcome == "" {
+				continue
+			}
+			counts[outcome]++
+			total++
+		}
+	}
+
+	if total == 0 {
+		return 0, 0, 0
+	}
+
+	percent := func(outcome string) float64 {
+		return float64(counts[outcome]) / float64(total) * 100
+	}
+	return percent(lottery.OutcomeHome), percent(lottery.OutcomeDraw), percent(lottery.OutcomeAway)
+}
+
 // calculateNumberFrequency calcula frequência de cada número nos sorteios
 func calculateNumberFrequency(draws []lottery.Draw, maxNumber int) map[int]int {
 	frequency := make(map[int]int)
@@ -1236,3 +1498,23 @@ func getCommonNumbers(numbers1, numbers2 []int) int {
 
 	return common
 }
+
+// promptPricing monta a tabela de preços oficiais do prompt com os preços em vigor hoje
+func promptPricing() string {
+	var lines []string
+	for _, def := range lottery.All() {
+		lines = append(lines, lottery.PriceList(def.Type, time.Now()))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// promptRules monta as regras de preenchimento obrigatórias do prompt a partir do registro de loterias
+func promptRules() string {
+	var lines []string
+	for _, def := range lottery.All() {
+		if def.PromptRules != "" {
+			lines = append(lines, "• "+def.PromptRules)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"lottery-optimizer-gui/internal/database"
 	"lottery-optimizer-gui/internal/logs"
+	"lottery-optimizer-gui/internal/lottery"
 	"math"
 	"sort"
 	"time"
@@ -35,9 +36,11 @@ type PerformanceMetrics struct {
 	Last90Days  PeriodMetrics `json:"last90Days"`
 	Last365Days PeriodMetrics `json:"last365Days"`
 
-	// Por Loteria
-	MegaSena  LotteryMetrics `json:"megaSena"`
-	Lotofacil LotteryMetrics `json:"lotofacil"`
+	// Por Loteria, indexado pelo tipo registrado (ex: "megasena", "lotofacil")
+	Lotteries map[string]LotteryMetrics `json:"lotteries"`
+
+	// Teimosinhas: apostas repetidas em concursos consecutivos, consolidadas por sequência
+	Recurring RecurringMetrics `json:"recurring"`
 
 	// Análise Temporal
 	PerformanceHistory []DailyPerformance `json:"performanceHistory"`
@@ -66,6 +69,18 @@ type LotteryMetrics struct {
 	FavoriteNumbers []int   `json:"favoriteNumbers"`
 }
 
+// RecurringMetrics consolida as Teimosinhas; cada sequência conta como uma única aposta
+type RecurringMetrics struct {
+	Bets          int     `json:"bets"`          // Teimosinhas registradas
+	Contests      int     `json:"contests"`      // Concursos cobertos pelas Teimosinhas
+	Completed     int     `json:"completed"`     // Teimosinhas com todos os concursos conferidos
+	WinningBets   int     `json:"winningBets"`   // Teimosinhas premiadas em ao menos um concurso
+	Investment    float64 `json:"investment"`    // Custo total pago nas compras
+	Winnings      float64 `json:"winnings"`      // Soma dos prêmios de todos os concursos
+	ROI           float64 `json:"roi"`           // Winnings - Investment
+	ROIPercentage float64 `json:"roiPercentage"` // ROI sobre o investimento
+}
+
 // DailyPerformance representa performance diária
 type DailyPerformance struct {
 	Date       time.Time `json:"date"`
@@ -96,6 +111,12 @@ type NumberFrequency struct {
 	IsCold    bool      `json:"isCold"`
 }
 
+// ColumnFrequency representa a frequência dos dígitos de uma coluna em apostas posicionais
+type ColumnFrequency struct {
+	Column      int               `json:"column"` // Coluna (a partir de 1)
+	Frequencies []NumberFrequency `json:"frequencies"`
+}
+
 // CalculatePerformanceMetrics calcula todas as métricas de performance
 func CalculatePerformanceMetrics() (*PerformanceMetrics, error) {
 	logs.LogAnalytics("🚀 Iniciando cálculo de métricas de performance...")
@@ -127,6 +148,9 @@ func CalculatePerformanceMetrics() (*PerformanceMetrics, error) {
 	// Calcular métricas por loteria
 	calculateLotteryMetrics(metrics, savedGames)
 
+	// Consolidar Teimosinhas
+	calculateRecurringMetrics(metrics, savedGames)
+
 	// Calcular histórico de performance
 	calculatePerformanceHistory(metrics, savedGames)
 
@@ -292,21 +316,64 @@ func calculatePeriodStats(games []database.SavedGame, since time.Time) PeriodMet
 	}
 }
 
-// calculateLotteryMetrics calcula métricas por loteria
+// calculateLotteryMetrics calcula métricas por loteria registrada
 func calculateLotteryMetrics(metrics *PerformanceMetrics, games []database.SavedGame) {
-	megaSenaGames := filterGamesByLottery(games, "Mega-Sena")
-	lotofacilGames := filterGamesByLottery(games, "Lotofácil")
+	metrics.Lotteries = make(map[string]LotteryMetrics)
+	for _, def := range lottery.All() {
+		metrics.Lotteries[string(def.Type)] = calculateLotteryStats(def.Name(), filterGamesByLottery(games, def.Type))
+	}
+}
+
+// calculateRecurringMetrics agrupa os jogos de cada Teimosinha e consolida custo e prêmios da sequência
+func calculateRecurringMetrics(metrics *PerformanceMetrics, games []database.SavedGame) {
+	sequences := make(map[string][]database.SavedGame)
+	for _, game := range games {
+		if game.RecurringID != "" {
+			sequences[game.RecurringID] = append(sequences[game.RecurringID], game)
+		}
+	}
+
+	recurring := RecurringMetrics{Bets: len(sequences)}
+	for _, sequence := range sequences {
+		completed := true
+		won := false
+
+		for _, game := range sequence {
+			recurring.Contests++
+			recurring.Investment += game.Cost
+
+			if game.Status != "checked" {
+				completed = false
+				continue
+			}
+			if game.Prize > 0 {
+				recurring.Winnings += game.Prize
+				won = true
+			}
+		}
 
-	metrics.MegaSena = calculateLotteryStats("Mega-Sena", megaSenaGames)
-	metrics.Lotofacil = calculateLotteryStats("Lotofácil", lotofacilGames)
+		if completed {
+			recurring.Completed++
+		}
+		if won {
+			recurring.WinningBets++
+		}
+	}
+
+	if recurring.Investment > 0 {
+		recurring.ROI = recurring.Winnings - recurring.Investment
+		recurring.ROIPercentage = (recurring.ROI / recurring.Investment) * 100
+	}
+
+	metrics.Recurring = recurring
 }
 
-// filterGamesByLottery filtra jogos por tipo de loteria
-func filterGamesByLottery(games []database.SavedGame, lotteryType string) []database.SavedGame {
+// filterGamesByLottery filtra jogos por tipo de loteria, aceitando grafias legadas (ex: "mega-sena")
+func filterGamesByLottery(games []database.SavedGame, ltype lottery.LotteryType) []database.SavedGame {
 	var filtered []database.SavedGame
 
 	for _, game := range games {
-		if game.LotteryType == lotteryType {
+		if parsed, err := lottery.ParseLotteryType(game.LotteryType); err == nil && parsed == ltype {
 			filtered = append(filtered, game)
 		}
 	}
@@ -547,10 +614,26 @@ func GetNumberFrequencyAnalysis(lotteryType string) ([]NumberFrequency, error) {
 
 	// Converter para slice e calcular hot/cold
 	var frequencies []NumberFrequency
-	var allFreqs []int
-
 	for _, freq := range numberStats {
 		frequencies = append(frequencies, *freq)
+	}
+
+	markHotAndCold(frequencies)
+
+	// Ordenar por frequência
+	sort.Slice(frequencies, func(i, j int) bool {
+		return frequencies[i].Frequency > frequencies[j].Frequency
+	})
+
+	logs.LogAnalytics("✅ Análise concluída: %d números analisados", len(frequencies))
+
+	return frequencies, nil
+}
+
+// markHotAndCold marca números hot/cold pela distância da frequência média
+func markHotAndCold(frequencies []NumberFrequency) {
+	var allFreqs []int
+	for _, freq := range frequencies {
 		allFreqs = append(allFreqs, freq.Frequency)
 	}
 
@@ -578,13 +661,62 @@ func GetNumberFrequencyAnalysis(lotteryType string) ([]NumberFrequency, error) {
 			}
 		}
 	}
+}
 
-	// Ordenar por frequência
-	sort.Slice(frequencies, func(i, j int) bool {
-		return frequencies[i].Frequency > frequencies[j].Frequency
-	})
+// GetColumnFrequencyAnalysis retorna a frequência dos dígitos em cada coluna das apostas posicionais (ex: Super Sete)
+// Como cada coluna é independente, hot/cold é calculado separadamente por coluna
+func GetColumnFrequencyAnalysis(lotteryType string) ([]ColumnFrequency, error) {
+	logs.LogAnalytics("🔍 Analisando frequência por coluna para %s...", lotteryType)
 
-	logs.LogAnalytics("✅ Análise concluída: %d números analisados", len(frequencies))
+	games, err := database.GetGamesByLottery(lotteryType)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar jogos: %v", err)
+	}
 
-	return frequencies, nil
+	columnStats := make(map[int]map[int]*NumberFrequency)
+
+	for _, game := range games {
+		for i, column := range game.Columns {
+			if columnStats[i] == nil {
+				columnStats[i] = make(map[int]*NumberFrequency)
+			}
+
+			for _, digit := range column {
+				stats := columnStats[i][digit]
+				if stats == nil {
+					stats = &NumberFrequency{Number: digit}
+					columnStats[i][digit] = stats
+				}
+
+				stats.Frequency++
+
+				if game.CreatedAt.After(stats.LastSeen) {
+					stats.LastSeen = game.CreatedAt
+				}
+			}
+		}
+	}
+
+	var columns []ColumnFrequency
+	for i := 0; i < len(columnStats); i++ {
+		var frequencies []NumberFrequency
+		for _, freq := range columnStats[i] {
+			frequencies = append(frequencies, *freq)
+		}
+
+		markHotAndCold(frequencies)
+
+		sort.Slice(frequencies, func(a, b int) bool {
+			if frequencies[a].Frequency == frequencies[b].Frequency {
+				return frequencies[a].Number < frequencies[b].Number
+			}
+			return frequencies[a].Frequency > frequencies[b].Frequency
+		})
+
+		columns = append(columns, ColumnFrequency{Column: i + 1, Frequencies: frequencies})
+	}
+
+	logs.LogAnalytics("✅ Análise concluída: %d colunas analisadas", len(columns))
+
+	return columns, nil
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/spf13/viper"
 	"gopkg.in/yaml.v3"
@@ -30,6 +31,39 @@ type AppConfig struct {
 	DefaultBudget int    `yaml:"default_budget"`
 	LogLevel      string `yaml:"log_level"`
 	DataSourceURL string `yaml:"data_source_url"`
+
+	FetchConcurrency   int     `yaml:"fetch_concurrency"`     // Concursos buscados em paralelo
+	FetchRatePerSecond float64 `yaml:"fetch_rate_per_second"` // Limite de requisições por segundo à API
+
+	DataSources []DataSourceConfig `yaml:"data_sources"` // Fontes de sorteios em ordem de prioridade
+
+	DataMode    string `yaml:"data_mode"`    // "live" (padrão), "record" ou "replay"; também via --data-mode
+	FixturesDir string `yaml:"fixtures_dir"` // Respostas gravadas em record e servidas em replay
+
+	Calendar CalendarConfig `yaml:"calendar"` // Datas por ano que substituem o calendário padrão de sorteios
+}
+
+// CalendarConfig datas de sorteio informadas pelo usuário, por ano
+// Um ano com no_draw_days usa só essa lista; uma loteria com special_draws em um ano usa só esses concursos
+type CalendarConfig struct {
+	NoDrawDays   []CalendarDateConfig `yaml:"no_draw_days" mapstructure:"no_draw_days"`
+	SpecialDraws []CalendarDateConfig `yaml:"special_draws" mapstructure:"special_draws"`
+}
+
+// CalendarDateConfig uma data do calendário de sorteios
+type CalendarDateConfig struct {
+	Date    string `yaml:"date"`    // AAAA-MM-DD
+	Name    string `yaml:"name"`    // Ex: "Carnaval", "Quina de São João"
+	Lottery string `yaml:"lottery"` // special_draws: loteria do concurso especial (ex: "quina")
+}
+
+// DataSourceConfig configura uma fonte de sorteios da cadeia de failover
+type DataSourceConfig struct {
+	Type      string `yaml:"type"`                                 // "caixa", "mirror" ou "file"
+	Name      string `yaml:"name"`                                 // Nome exibido no status das fontes
+	URL       string `yaml:"url"`                                  // caixa/mirror: URL base ou modelo com {slug}, {lottery} e {number}
+	LatestURL string `yaml:"latest_url" mapstructure:"latest_url"` // mirror: URL do último sorteio (opcional)
+	Path      string `yaml:"path"`                                 // file: diretório com {loteria}.json
 }
 
 var GlobalConfig *Config
@@ -72,13 +106,44 @@ func Init() {
 			DefaultBudget: viper.GetInt("app.default_budget"),
 			LogLevel:      viper.GetString("app.log_level"),
 			DataSourceURL: viper.GetString("app.data_source_url"),
+
+			FetchConcurrency:   viper.GetInt("app.fetch_concurrency"),
+			FetchRatePerSecond: viper.GetFloat64("app.fetch_rate_per_second"),
+
+			DataMode:    viper.GetString("app.data_mode"),
+			FixturesDir: viper.GetString("app.fixtures_dir"),
 		},
 	}
 
+	// A GUI não usa cobra: --data-mode é lido direto dos argumentos
+	if mode := DataModeFromArgs(os.Args[1:]); mode != "" {
+		GlobalConfig.App.DataMode = mode
+	}
+
+	if err := viper.UnmarshalKey("app.data_sources", &GlobalConfig.App.DataSources); err != nil {
+		fmt.Printf("Aviso: app.data_sources inválido: %v\n", err)
+	}
+	if err := viper.UnmarshalKey("app.calendar", &GlobalConfig.App.Calendar); err != nil {
+		fmt.Printf("Aviso: app.calendar inválido: %v\n", err)
+	}
+
 	// Configurações padrão
 	setDefaults()
 }
 
+// DataModeFromArgs procura --data-mode nos argumentos ("--data-mode replay" ou "--data-mode=replay")
+func DataModeFromArgs(args []string) string {
+	for i, arg := range args {
+		if value, ok := strings.CutPrefix(arg, "--data-mode="); ok {
+			return value
+		}
+		if arg == "--data-mode" && i+1 < len(args) {
+			return args[i+1]
+		}
+	}
+	return ""
+}
+
 // getClaudeAPIKey obtém a chave da API do Claude
 func getClaudeAPIKey() string {
 	// Prioridade: flag -> env var -> config file
@@ -128,6 +193,18 @@ func setDefaults() {
 		GlobalConfig.App.DataSourceURL = "https://servicebus2.caixa.gov.br/portaldeloterias/api"
 	}
 
+	if GlobalConfig.App.FetchConcurrency <= 0 {
+		GlobalConfig.App.FetchConcurrency = 4
+	}
+
+	if GlobalConfig.App.FetchRatePerSecond <= 0 {
+		GlobalConfig.App.FetchRatePerSecond = 2 // 2 requisições por segundo
+	}
+
+	if GlobalConfig.App.DataMode == "" {
+		GlobalConfig.App.DataMode = "live"
+	}
+
 	GlobalConfig.App.CacheEnabled = true
 }
 
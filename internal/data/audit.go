@@ -0,0 +1,269 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"lottery-optimizer-gui/internal/logs"
+	"lottery-optimizer-gui/internal/lottery"
+	"sort"
+	"time"
+)
+
+// Tipos de problema encontrados na auditoria dos sorteios guardados
+const (
+	IssueMissing    = "missing"     // Concurso ausente entre o primeiro e o último guardados
+	IssueWrongCount = "wrong_count" // Quantidade de dezenas diferente da regra da loteria
+	IssueOutOfRange = "out_of_range"
+	IssueDuplicate  = "duplicate"
+	IssueDateOrder  = "date_order" // Data anterior à do concurso anterior (ou ausente)
+)
+
+// AuditIssue descreve um problema em um concurso guardado
+type AuditIssue struct {
+	Number int    `json:"number"`
+	Kind   string `json:"kind"`
+	Detail string `json:"detail"`
+}
+
+// AuditReport resultado da auditoria (e do reparo) dos sorteios de uma loteria
+type AuditReport struct {
+	LotteryType  string       `json:"lottery_type"`
+	Checked      int          `json:"checked"`
+	FirstContest int          `json:"first_contest"`
+	LastContest  int          `json:"last_contest"`
+	Issues       []AuditIssue `json:"issues"`
+	Repaired     []int        `json:"repaired,omitempty"`   // Concursos buscados de novo e corrigidos
+	Unrepaired   []int        `json:"unrepaired,omitempty"` // Concursos que nenhuma fonte conseguiu corrigir
+	Remaining    []AuditIssue `json:"remaining,omitempty"`  // Problemas que continuam após o reparo
+	CheckedAt    time.Time    `json:"checked_at"`
+}
+
+// HasIssues indica se a auditoria encontrou algum problema
+func (r AuditReport) HasIssues() bool {
+	return len(r.Issues) > 0
+}
+
+// AffectedContests retorna os concursos que precisam ser buscados de novo, em ordem crescente
+func (r AuditReport) AffectedContests() []int {
+	return affectedContests(r.Issues)
+}
+
+// AuditDraws confere os sorteios (em ordem crescente de concurso) contra as regras da loteria
+// Aponta concursos ausentes entre o primeiro e o último, dezenas em quantidade errada,
+// fora da faixa ou repetidas, e datas fora de ordem
+func AuditDraws(ltype lottery.LotteryType, draws []lottery.Draw) []AuditIssue {
+	var issues []AuditIssue
+	if len(draws) == 0 {
+		return issues
+	}
+
+	sorted := make([]lottery.Draw, len(draws))
+	copy(sorted, draws)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Number < sorted[j].Number })
+
+	for i, draw := range sorted {
+		if i > 0 {
+			previous := sorted[i-1]
+			for missing := previous.Number + 1; missing < draw.Number; missing++ {
+				issues = append(issues, AuditIssue{Number: missing, Kind: IssueMissing, Detail: "concurso não guardado"})
+			}
+
+			if !draw.Date.Time().IsZero() && !previous.Date.Time().IsZero() && draw.Date.Time().Before(previous.Date.Time()) {
+				issues = append(issues, AuditIssue{
+					Number: draw.Number,
+					Kind:   IssueDateOrder,
+					Detail: fmt.Sprintf("data %s anterior à do concurso %d (%s)", draw.Date, previous.Number, previous.Date),
+				})
+			}
+		}
+
+		if draw.Date.Time().IsZero() {
+			issues = append(issues, AuditIssue{Number: draw.Number, Kind: IssueDateOrder, Detail: "sorteio sem data"})
+		}
+
+		issues = append(issues, validateDraw(ltype, draw)...)
+	}
+
+	return issues
+}
+
+// validateDraw confere as dezenas de um sorteio contra as regras da loteria
+func validateDraw(ltype lottery.LotteryType, draw lottery.Draw) []AuditIssue {
+	rules := lottery.GetRules(ltype)
+
+	// Na Loteca não há dezenas: confere só a grade de partidas
+	if rules.IsMatchPool() {
+		outcomes := draw.MatchOutcomes()
+		if len(outcomes) != rules.Matches {
+			return []AuditIssue{{
+				Number: draw.Number,
+				Kind:   IssueWrongCount,
+				Detail: fmt.Sprintf("%d partidas, esperado %d", len(outcomes), rules.Matches),
+			}}
+		}
+		return nil
+	}
+
+	minNumber, maxNumber := 1, rules.NumberRange
+	if rules.IsPositional() {
+		minNumber, maxNumber = rules.MinDigit, rules.MaxDigit
+	}
+
+	// Na Super Sete cada dígito é de uma coluna, então repetições são válidas
+	sets := [][]int{draw.Numbers.ToIntSlice()}
+	if rules.HasMultipleDraws() {
+		sets = append(sets, draw.SecondNumbers.ToIntSlice())
+	}
+
+	var issues []AuditIssue
+	for i, numbers := range sets {
+		label := "dezenas"
+		if len(sets) > 1 {
+			label = fmt.Sprintf("dezenas do %dº sorteio", i+1)
+		}
+		issues = append(issues, validateNumbers(draw.Number, label, numbers, rules.ResultNumbers, minNumber, maxNumber, !rules.IsPositional())...)
+	}
+
+	if rules.HasSecondary() {
+		issues = append(issues, validateNumbers(draw.Number, rules.SecondaryName, draw.Secondary.ToIntSlice(), rules.SecondaryResults, 1, rules.SecondaryRange, true)...)
+	}
+
+	return issues
+}
+
+// validateNumbers confere quantidade, faixa e repetição de um conjunto de dezenas
+func validateNumbers(number int, label string, numbers []int, expected, minNumber, maxNumber int, unique bool) []AuditIssue {
+	var issues []AuditIssue
+
+	if len(numbers) != expected {
+		issues = append(issues, AuditIssue{
+			Number: number,
+			Kind:   IssueWrongCount,
+			Detail: fmt.Sprintf("%d %s, esperado %d", len(numbers), label, expected),
+		})
+	}
+
+	seen := make(map[int]bool)
+	for _, num := range numbers {
+		if num < minNumber || num > maxNumber {
+			issues = append(issues, AuditIssue{
+				Number: number,
+				Kind:   IssueOutOfRange,
+				Detail: fmt.Sprintf("%s: %d fora da faixa %d-%d", label, num, minNumber, maxNumber),
+			})
+		}
+		if unique && seen[num] {
+			issues = append(issues, AuditIssue{
+				Number: number,
+				Kind:   IssueDuplicate,
+				Detail: fmt.Sprintf("%s: %d repetida", label, num),
+			})
+		}
+		seen[num] = true
+	}
+
+	return issues
+}
+
+// affectedContests retorna os concursos apontados pelos problemas, sem repetição e em ordem crescente
+// Em datas fora de ordem não dá para saber qual dos dois está errado, então o anterior também é buscado
+func affectedContests(issues []AuditIssue) []int {
+	seen := make(map[int]bool)
+	var numbers []int
+	add := func(number int) {
+		if number > 0 && !seen[number] {
+			seen[number] = true
+			numbers = append(numbers, number)
+		}
+	}
+
+	for _, issue := range issues {
+		add(issue.Number)
+		if issue.Kind == IssueDateOrder {
+			add(issue.Number - 1)
+		}
+	}
+
+	sort.Ints(numbers)
+	return numbers
+}
+
+// AuditStoredDraws audita todos os sorteios guardados da loteria no banco local
+// Concursos anteriores ao primeiro guardado não contam como ausentes (ver Backfill)
+func (c *Client) AuditStoredDraws(ltype lottery.LotteryType) (AuditReport, error) {
+	report := AuditReport{
+		LotteryType: string(ltype),
+		CheckedAt:   time.Now(),
+	}
+
+	if c.store == nil {
+		return report, fmt.Errorf("banco local de sorteios não disponível")
+	}
+
+	draws, err := c.store.AllDraws(ltype)
+	if err != nil {
+		return report, err
+	}
+
+	report.Checked = len(draws)
+	if len(draws) > 0 {
+		report.FirstContest = draws[0].Number
+		report.LastContest = draws[len(draws)-1].Number
+	}
+	report.Issues = AuditDraws(ltype, draws)
+
+	if report.HasIssues() {
+		logs.LogData("🔎 Auditoria de %s: %d problemas em %d sorteios (%d a %d)",
+			ltype, len(report.Issues), report.Checked, report.FirstContest, report.LastContest)
+	} else {
+		logs.LogData("🔎 Auditoria de %s: %d sorteios sem problemas", ltype, report.Checked)
+	}
+
+	return report, nil
+}
+
+// RepairDraws audita os sorteios guardados e busca de novo os concursos com problema
+// A busca passa pela cadeia de fontes, então qualquer fonte disponível pode corrigir o concurso;
+// um sorteio que continua inválido na fonte não substitui o guardado
+func (c *Client) RepairDraws(ctx context.Context, ltype lottery.LotteryType) (AuditReport, error) {
+	report, err := c.AuditStoredDraws(ltype)
+	if err != nil || !report.HasIssues() {
+		return report, err
+	}
+
+	affected := report.AffectedContests()
+	logs.LogData("🔧 Reparando %d concursos de %s", len(affected), ltype)
+
+	var repaired []lottery.Draw
+	for _, result := range c.fetchContests(ctx, ltype, affected) {
+		if result.Err != nil {
+			logs.LogError(logs.CategoryData, "Reparo de %s: erro ao buscar concurso %d: %v", ltype, result.Number, result.Err)
+			report.Unrepaired = append(report.Unrepaired, result.Number)
+			continue
+		}
+
+		if problems := validateDraw(ltype, *result.Draw); len(problems) > 0 {
+			logs.LogError(logs.CategoryData, "Reparo de %s: concurso %d continua inválido na fonte: %s", ltype, result.Number, problems[0].Detail)
+			report.Unrepaired = append(report.Unrepaired, result.Number)
+			continue
+		}
+
+		repaired = append(repaired, *result.Draw)
+		report.Repaired = append(report.Repaired, result.Number)
+	}
+
+	if _, err := c.store.SaveDraws(ltype, repaired); err != nil {
+		return report, err
+	}
+
+	// Audita de novo para informar o que ainda ficou pendente
+	after, err := c.AuditStoredDraws(ltype)
+	if err != nil {
+		return report, err
+	}
+	report.Remaining = after.Issues
+
+	logs.LogData("🔧 Reparo de %s: %d corrigidos, %d sem correção, %d problemas restantes",
+		ltype, len(report.Repaired), len(report.Unrepaired), len(report.Remaining))
+	return report, nil
+}
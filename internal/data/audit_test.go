@@ -0,0 +1,188 @@
+package data
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"lottery-optimizer-gui/internal/lottery"
+)
+
+// auditDraw monta um sorteio com as dezenas informadas; day vazio deixa o sorteio sem data
+func auditDraw(t *testing.T, number int, day string, numbers ...int) lottery.Draw {
+	t.Helper()
+	draw := lottery.Draw{Number: number, Numbers: lottery.StringIntSlice(numbers)}
+	if day != "" {
+		date, err := time.Parse("2006-01-02", day)
+		if err != nil {
+			t.Fatalf("data inválida %q: %v", day, err)
+		}
+		draw.Date = lottery.BrazilianDate(date)
+	}
+	return draw
+}
+
+// issueKey resume o problema em concurso e tipo (o detalhe é só texto para o usuário)
+type issueKey struct {
+	number int
+	kind   string
+}
+
+func TestAuditDraws(t *testing.T) {
+	withSecondary := func(draw lottery.Draw, secondary ...int) lottery.Draw {
+		draw.Secondary = lottery.StringIntSlice(secondary)
+		return draw
+	}
+	withSecondDraw := func(draw lottery.Draw, numbers ...int) lottery.Draw {
+		draw.SecondNumbers = lottery.StringIntSlice(numbers)
+		return draw
+	}
+
+	tests := []struct {
+		name  string
+		ltype lottery.LotteryType
+		draws []lottery.Draw
+		want  []issueKey
+	}{
+		{
+			name:  "sorteios válidos",
+			ltype: lottery.MegaSena,
+			draws: []lottery.Draw{
+				auditDraw(t, 1, "2025-01-04", 1, 2, 3, 4, 5, 6),
+				auditDraw(t, 2, "2025-01-08", 10, 20, 30, 40, 50, 60),
+			},
+		},
+		{
+			name:  "fora de ordem na entrada",
+			ltype: lottery.MegaSena,
+			draws: []lottery.Draw{
+				auditDraw(t, 3, "2025-01-11", 1, 2, 3, 4, 5, 6),
+				auditDraw(t, 1, "2025-01-04", 1, 2, 3, 4, 5, 6),
+				auditDraw(t, 2, "2025-01-08", 1, 2, 3, 4, 5, 6),
+			},
+		},
+		{
+			name:  "concursos ausentes",
+			ltype: lottery.MegaSena,
+			draws: []lottery.Draw{
+				auditDraw(t, 1, "2025-01-04", 1, 2, 3, 4, 5, 6),
+				auditDraw(t, 4, "2025-01-15", 1, 2, 3, 4, 5, 6),
+			},
+			want: []issueKey{{2, IssueMissing}, {3, IssueMissing}},
+		},
+		{
+			name:  "dezenas em quantidade errada",
+			ltype: lottery.MegaSena,
+			draws: []lottery.Draw{auditDraw(t, 1, "2025-01-04", 1, 2, 3, 4, 5)},
+			want:  []issueKey{{1, IssueWrongCount}},
+		},
+		{
+			name:  "dezena fora da faixa",
+			ltype: lottery.MegaSena,
+			draws: []lottery.Draw{auditDraw(t, 1, "2025-01-04", 1, 2, 3, 4, 5, 61)},
+			want:  []issueKey{{1, IssueOutOfRange}},
+		},
+		{
+			name:  "dezena repetida",
+			ltype: lottery.MegaSena,
+			draws: []lottery.Draw{auditDraw(t, 1, "2025-01-04", 1, 2, 3, 4, 5, 5)},
+			want:  []issueKey{{1, IssueDuplicate}},
+		},
+		{
+			name:  "data anterior à do concurso anterior",
+			ltype: lottery.MegaSena,
+			draws: []lottery.Draw{
+				auditDraw(t, 1, "2025-01-08", 1, 2, 3, 4, 5, 6),
+				auditDraw(t, 2, "2025-01-04", 1, 2, 3, 4, 5, 6),
+			},
+			want: []issueKey{{2, IssueDateOrder}},
+		},
+		{
+			name:  "sorteio sem data",
+			ltype: lottery.MegaSena,
+			draws: []lottery.Draw{auditDraw(t, 1, "", 1, 2, 3, 4, 5, 6)},
+			want:  []issueKey{{1, IssueDateOrder}},
+		},
+		{
+			name:  "trevos ausentes na +Milionária",
+			ltype: lottery.MaisMilionaria,
+			draws: []lottery.Draw{auditDraw(t, 1, "2025-01-04", 1, 2, 3, 4, 5, 6)},
+			want:  []issueKey{{1, IssueWrongCount}},
+		},
+		{
+			name:  "trevo fora da faixa",
+			ltype: lottery.MaisMilionaria,
+			draws: []lottery.Draw{withSecondary(auditDraw(t, 1, "2025-01-04", 1, 2, 3, 4, 5, 6), 1, 7)},
+			want:  []issueKey{{1, IssueOutOfRange}},
+		},
+		{
+			name:  "dígitos repetidos valem na Super Sete",
+			ltype: lottery.SuperSete,
+			draws: []lottery.Draw{auditDraw(t, 1, "2025-01-06", 0, 0, 9, 9, 5, 5, 5)},
+		},
+		{
+			name:  "Lotofácil sorteia 15 dezenas",
+			ltype: lottery.Lotofacil,
+			draws: []lottery.Draw{
+				auditDraw(t, 1, "2025-01-06", 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15),
+				auditDraw(t, 2, "2025-01-07", 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20),
+			},
+			want: []issueKey{{2, IssueWrongCount}},
+		},
+		{
+			name:  "segundo sorteio da Dupla Sena",
+			ltype: lottery.DuplaSena,
+			draws: []lottery.Draw{withSecondDraw(auditDraw(t, 1, "2025-01-07", 1, 2, 3, 4, 5, 6), 7, 8, 9, 10, 11, 11)},
+			want:  []issueKey{{1, IssueDuplicate}},
+		},
+		{
+			name:  "nenhum sorteio",
+			ltype: lottery.MegaSena,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []issueKey
+			for _, issue := range AuditDraws(tt.ltype, tt.draws) {
+				got = append(got, issueKey{issue.Number, issue.Kind})
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AuditDraws() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAffectedContests(t *testing.T) {
+	tests := []struct {
+		name   string
+		issues []AuditIssue
+		want   []int
+	}{
+		{"sem problemas", nil, nil},
+		{
+			name:   "ordem crescente sem repetição",
+			issues: []AuditIssue{{Number: 5, Kind: IssueDuplicate}, {Number: 2, Kind: IssueMissing}, {Number: 5, Kind: IssueOutOfRange}},
+			want:   []int{2, 5},
+		},
+		{
+			name:   "data fora de ordem inclui o concurso anterior",
+			issues: []AuditIssue{{Number: 8, Kind: IssueDateOrder}},
+			want:   []int{7, 8},
+		},
+		{
+			name:   "primeiro concurso sem anterior",
+			issues: []AuditIssue{{Number: 1, Kind: IssueDateOrder}},
+			want:   []int{1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := affectedContests(tt.issues); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("affectedContests() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,276 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lottery-optimizer-gui/internal/logs"
+	"lottery-optimizer-gui/internal/lottery"
+	"sync"
+	"time"
+)
+
+// Status do backfill do histórico
+const (
+	BackfillRunning   = "running"
+	BackfillCompleted = "completed"
+	BackfillBlocked   = "blocked"   // API retornou 403; retomar mais tarde
+	BackfillCancelled = "cancelled" // Interrompido pelo usuário
+	BackfillFailed    = "failed"
+)
+
+// maxConsecutiveBackfillErrors interrompe o backfill quando a API parece fora do ar
+const maxConsecutiveBackfillErrors = 10
+
+// backfillBatchPerWorker define o tamanho dos lotes guardados de uma vez (por worker)
+const backfillBatchPerWorker = 5
+
+// BackfillProgress descreve o andamento do backfill de uma loteria
+type BackfillProgress struct {
+	LotteryType    string    `json:"lottery_type"`
+	Status         string    `json:"status"`
+	Done           int       `json:"done"`    // Concursos guardados no banco local
+	Total          int       `json:"total"`   // Último concurso da loteria
+	Fetched        int       `json:"fetched"` // Concursos baixados nesta execução
+	Errors         int       `json:"errors"`
+	FailedContests []int     `json:"failed_contests,omitempty"`
+	LastError      string    `json:"last_error,omitempty"`
+	StartedAt      time.Time `json:"started_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+	ETASeconds     int       `json:"eta_seconds"` // Estimativa para terminar (0 = desconhecida)
+}
+
+// Percent retorna o percentual do histórico já guardado
+func (p BackfillProgress) Percent() float64 {
+	if p.Total == 0 {
+		return 0
+	}
+	return float64(p.Done) / float64(p.Total) * 100
+}
+
+// ETA retorna a estimativa de tempo restante
+func (p BackfillProgress) ETA() time.Duration {
+	return time.Duration(p.ETASeconds) * time.Second
+}
+
+// Backfill baixa todo o histórico da loteria, do concurso 1 ao último, guardando cada sorteio ao chegar
+// Concursos já guardados são pulados, então uma execução interrompida (queda, 403) é retomada de onde parou
+func (c *Client) Backfill(ctx context.Context, ltype lottery.LotteryType, onProgress func(BackfillProgress)) (BackfillProgress, error) {
+	progress := BackfillProgress{
+		LotteryType: string(ltype),
+		Status:      BackfillRunning,
+		StartedAt:   time.Now(),
+	}
+
+	report := func() {
+		progress.UpdatedAt = time.Now()
+		if onProgress != nil {
+			onProgress(progress)
+		}
+	}
+
+	finish := func(status string, err error) (BackfillProgress, error) {
+		progress.Status = status
+		progress.ETASeconds = 0
+		if err != nil {
+			progress.LastError = err.Error()
+		}
+		report()
+		logs.LogData("📚 Backfill de %s: %s (%d/%d concursos, %d erros)", ltype, status, progress.Done, progress.Total, progress.Errors)
+		return progress, err
+	}
+
+	if c.store == nil {
+		return finish(BackfillFailed, fmt.Errorf("banco local de sorteios não disponível"))
+	}
+
+	latest, err := c.fetchLatestDraw(ltype)
+	if err != nil {
+		if errors.Is(err, ErrBlocked) {
+			return finish(BackfillBlocked, err)
+		}
+		return finish(BackfillFailed, err)
+	}
+	if _, err := c.store.SaveDraws(ltype, []lottery.Draw{*latest}); err != nil {
+		return finish(BackfillFailed, err)
+	}
+
+	stored, err := c.store.StoredNumbers(ltype, 1, latest.Number)
+	if err != nil {
+		return finish(BackfillFailed, err)
+	}
+
+	progress.Total = latest.Number
+	progress.Done = len(stored)
+	logs.LogData("📚 Backfill de %s: %d de %d concursos já guardados", ltype, progress.Done, progress.Total)
+	report()
+
+	var missing []int
+	for number := 1; number < latest.Number; number++ {
+		if !stored[number] {
+			missing = append(missing, number)
+		}
+	}
+
+	// Busca em lotes para guardar e reportar cada lote assim que chega
+	batchSize := c.concurrency * backfillBatchPerWorker
+	consecutiveErrors := 0
+	for len(missing) > 0 {
+		batch := missing
+		if len(batch) > batchSize {
+			batch = batch[:batchSize]
+		}
+		missing = missing[len(batch):]
+
+		var draws []lottery.Draw
+		var stopStatus string
+		var stopErr error
+		// Um concurso que falhou (mesmo bloqueado) fica registrado para a próxima execução, e o lote segue;
+		// só muitos erros seguidos interrompem o backfill, como bloqueado se o último foi um 403/429
+		for _, result := range c.fetchContests(ctx, ltype, batch) {
+			if result.Err == nil {
+				consecutiveErrors = 0
+				draws = append(draws, *result.Draw)
+				continue
+			}
+
+			if ctx.Err() != nil {
+				stopStatus, stopErr = BackfillCancelled, nil
+				continue
+			}
+
+			progress.Errors++
+			progress.FailedContests = append(progress.FailedContests, result.Number)
+			progress.LastError = result.Err.Error()
+			logs.LogError(logs.CategoryData, "Backfill de %s: erro no concurso %d: %v", ltype, result.Number, result.Err)
+
+			consecutiveErrors++
+			if consecutiveErrors >= maxConsecutiveBackfillErrors && stopStatus == "" {
+				stopStatus, stopErr = BackfillFailed, fmt.Errorf("%d erros seguidos, último: %w", consecutiveErrors, result.Err)
+				if isThrottled(result.Err) {
+					stopStatus = BackfillBlocked
+				}
+			}
+		}
+
+		// O que chegou antes da interrupção é guardado para a próxima execução retomar dali
+		// Só conta como feito o que foi gravado: concursos ainda não apurados continuam faltando
+		saved, err := c.store.SaveDraws(ltype, draws)
+		if err != nil {
+			return finish(BackfillFailed, err)
+		}
+		progress.Done += saved
+		progress.Fetched += len(draws)
+
+		if stopStatus != "" {
+			return finish(stopStatus, stopErr)
+		}
+
+		progress.ETASeconds = estimateRemaining(progress)
+		report()
+	}
+
+	if progress.Errors > 0 {
+		return finish(BackfillCompleted, fmt.Errorf("%d concursos não puderam ser baixados", progress.Errors))
+	}
+	return finish(BackfillCompleted, nil)
+}
+
+// estimateRemaining estima os segundos restantes pelo ritmo médio desta execução
+func estimateRemaining(progress BackfillProgress) int {
+	if progress.Fetched == 0 {
+		return 0
+	}
+	remaining := progress.Total - progress.Done - progress.Errors
+	if remaining <= 0 {
+		return 0
+	}
+	perContest := time.Since(progress.StartedAt) / time.Duration(progress.Fetched)
+	return int((perContest * time.Duration(remaining)).Seconds())
+}
+
+// BackfillManager acompanha os backfills em execução para consulta pela interface
+type BackfillManager struct {
+	mu       sync.Mutex
+	progress map[lottery.LotteryType]BackfillProgress
+	cancels  map[lottery.LotteryType]context.CancelFunc
+}
+
+// NewBackfillManager cria um gerenciador de backfills
+func NewBackfillManager() *BackfillManager {
+	return &BackfillManager{
+		progress: make(map[lottery.LotteryType]BackfillProgress),
+		cancels:  make(map[lottery.LotteryType]context.CancelFunc),
+	}
+}
+
+// Start inicia em segundo plano o backfill da loteria, se ainda não estiver rodando
+func (m *BackfillManager) Start(client *Client, ltype lottery.LotteryType) (BackfillProgress, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, running := m.cancels[ltype]; running {
+		return m.progress[ltype], fmt.Errorf("backfill de %s já está em andamento", ltype)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancels[ltype] = cancel
+	progress := BackfillProgress{
+		LotteryType: string(ltype),
+		Status:      BackfillRunning,
+		StartedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+	m.progress[ltype] = progress
+
+	go func() {
+		final, _ := client.Backfill(ctx, ltype, func(p BackfillProgress) {
+			m.mu.Lock()
+			m.progress[ltype] = p
+			m.mu.Unlock()
+		})
+
+		m.mu.Lock()
+		m.progress[ltype] = final
+		delete(m.cancels, ltype)
+		m.mu.Unlock()
+		cancel()
+	}()
+
+	return progress, nil
+}
+
+// Cancel interrompe o backfill da loteria; os concursos já guardados são mantidos
+func (m *BackfillManager) Cancel(ltype lottery.LotteryType) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	cancel, running := m.cancels[ltype]
+	if running {
+		cancel()
+	}
+	return running
+}
+
+// Progress retorna o andamento do backfill da loteria
+func (m *BackfillManager) Progress(ltype lottery.LotteryType) (BackfillProgress, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	progress, ok := m.progress[ltype]
+	return progress, ok
+}
+
+// AllProgress retorna o andamento de todos os backfills iniciados
+func (m *BackfillManager) AllProgress() []BackfillProgress {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	all := make([]BackfillProgress, 0, len(m.progress))
+	for _, def := range lottery.All() {
+		if progress, ok := m.progress[def.Type]; ok {
+			all = append(all, progress)
+		}
+	}
+	return all
+}
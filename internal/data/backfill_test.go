@@ -0,0 +1,74 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"lottery-optimizer-gui/internal/lottery"
+)
+
+// stubSource é uma fonte em memória; concursos ausentes voltam ErrDrawNotFound e err, se definido, vale para tudo
+type stubSource struct {
+	name   string
+	latest int
+	draws  map[int]lottery.Draw
+	err    error
+}
+
+func (s *stubSource) Name() string { return s.name }
+
+func (s *stubSource) Latest(ltype lottery.LotteryType) (*lottery.Draw, error) {
+	return s.Contest(ltype, s.latest)
+}
+
+func (s *stubSource) Contest(ltype lottery.LotteryType, number int) (*lottery.Draw, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	draw, ok := s.draws[number]
+	if !ok {
+		return nil, ErrDrawNotFound
+	}
+	return &draw, nil
+}
+
+func TestBackfillCountsOnlySavedDraws(t *testing.T) {
+	source := &stubSource{name: "stub", latest: 10, draws: make(map[int]lottery.Draw)}
+	for number := 1; number <= 10; number++ {
+		source.draws[number] = storedDrawWithPrizes(number)
+	}
+	// Concurso 4 ainda sem dezenas: chega da fonte, mas não é gravado
+	source.draws[4] = lottery.Draw{Number: 4}
+
+	store := newTestStore(t)
+	if _, err := store.SaveDraws(lottery.Quina, []lottery.Draw{storedDrawWithPrizes(2), storedDrawWithPrizes(3)}); err != nil {
+		t.Fatalf("SaveDraws: %v", err)
+	}
+
+	client := &Client{
+		store:       store,
+		sources:     newSourceChain([]DrawSource{source}),
+		limiter:     newRateLimiter(1000, 4),
+		concurrency: 2,
+	}
+
+	progress, err := client.Backfill(context.Background(), lottery.Quina, nil)
+	if err != nil {
+		t.Fatalf("Backfill() error = %v", err)
+	}
+	if progress.Status != BackfillCompleted || progress.Total != 10 {
+		t.Fatalf("Backfill() = %+v", progress)
+	}
+	// 2 já guardados + o último + 1, 5, 6, 7, 8 e 9; o concurso 4 foi baixado sem ser gravado
+	if progress.Done != 9 || progress.Fetched != 7 {
+		t.Errorf("Done = %d, Fetched = %d, want 9 e 7", progress.Done, progress.Fetched)
+	}
+
+	stored, err := store.StoredNumbers(lottery.Quina, 1, 10)
+	if err != nil {
+		t.Fatalf("StoredNumbers: %v", err)
+	}
+	if len(stored) != progress.Done || stored[4] {
+		t.Errorf("StoredNumbers() = %v, Done = %d", stored, progress.Done)
+	}
+}
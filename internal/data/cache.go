@@ -25,8 +25,11 @@ type CacheManager struct {
 // NewCacheManager cria um novo gerenciador de cache
 func NewCacheManager() *CacheManager {
 	homeDir, _ := os.UserHomeDir()
-	cacheDir := filepath.Join(homeDir, ".lottery-optimizer", "cache")
+	return newCacheManagerAt(filepath.Join(homeDir, ".lottery-optimizer", "cache"))
+}
 
+// newCacheManagerAt cria um gerenciador de cache no diretório informado
+func newCacheManagerAt(cacheDir string) *CacheManager {
 	// Criar diretório se não existir
 	os.MkdirAll(cacheDir, 0755)
 
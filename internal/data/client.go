@@ -1,29 +1,44 @@
 package data
 
 import (
-	"encoding/json"
+	"context"
+	"errors"
 	"fmt"
 	"lottery-optimizer-gui/internal/config"
 	"lottery-optimizer-gui/internal/logs"
 	"lottery-optimizer-gui/internal/lottery"
+	"path/filepath"
+	"sort"
 	"time"
 
 	"github.com/go-resty/resty/v2"
 )
 
+// Erros de recusa da API da CAIXA
+var (
+	ErrBlocked     = errors.New("API da CAIXA bloqueada (erro 403)")
+	ErrRateLimited = errors.New("API da CAIXA limitou as requisições (erro 429)")
+)
+
 // Client cliente para APIs de loterias
 type Client struct {
 	client       *resty.Client
 	baseURL      string
 	cacheManager *CacheManager
+	store        *DrawStore   // Banco local de sorteios (nil = só API e cache JSON)
+	sources      *sourceChain // Fontes de sorteios em ordem de prioridade, com failover
+	limiter      *rateLimiter // Limite de requisições compartilhado pelas buscas concorrentes
+	concurrency  int          // Quantidade de concursos buscados em paralelo
+	mode         string       // Modo de dados: live, record ou replay
 }
 
 // NewClient cria um novo cliente para APIs de loterias
 func NewClient() *Client {
 	client := resty.New()
 	client.SetTimeout(60 * time.Second) // Aumentado para 60 segundos
-	client.SetRetryCount(3)
-	client.SetRetryWaitTime(2 * time.Second)
+	// Sem novas tentativas no resty: fetchContestWithRetry já repete com backoff pelo rate limiter,
+	// e as duas camadas juntas multiplicariam as requisições a cada bloqueio
+	client.SetRetryCount(0)
 
 	// Headers para parecer mais com um browser real
 	client.SetHeaders(map[string]string{
@@ -40,15 +55,87 @@ func NewClient() *Client {
 		"Origin":          "https://loterias.caixa.gov.br",
 	})
 
+	concurrency := config.GlobalConfig.App.FetchConcurrency
+	if concurrency <= 0 {
+		concurrency = defaultFetchConcurrency
+	}
+
+	mode := configureDataMode(client, config.GlobalConfig.App)
+	applyCalendarConfig(config.GlobalConfig.App.Calendar)
+
+	// Em record e replay todo sorteio passa pela API (gravada ou reproduzida): o banco local
+	// responderia sem requisição e o replay dependeria do que já estava guardado. O cache JSON
+	// fica junto das fixtures para não misturar dados gravados com os do usuário
+	store := defaultDrawStore()
+	cacheManager := NewCacheManager()
+	if mode != DataModeLive {
+		store = nil
+		cacheManager = newCacheManagerAt(filepath.Join(fixturesDir(config.GlobalConfig.App), "cache"))
+	}
+
 	return &Client{
 		client:       client,
 		baseURL:      config.GlobalConfig.App.DataSourceURL,
-		cacheManager: NewCacheManager(),
+		cacheManager: cacheManager,
+		store:        store,
+		sources:      newSourceChain(newDrawSources(client, config.GlobalConfig.App)),
+		limiter:      newRateLimiter(config.GlobalConfig.App.FetchRatePerSecond, concurrency),
+		concurrency:  concurrency,
+		mode:         mode,
+	}
+}
+
+// applyCalendarConfig aplica ao calendário de sorteios as datas informadas em app.calendar
+// Datas ou loterias inválidas são ignoradas com aviso, sem impedir o app de abrir
+func applyCalendarConfig(cfg config.CalendarConfig) {
+	var noDrawDays []lottery.NoDrawDay
+	for _, day := range cfg.NoDrawDays {
+		date, err := time.ParseInLocation("2006-01-02", day.Date, lottery.SaoPaulo)
+		if err != nil {
+			logs.LogData("⚠️ Data inválida em app.calendar.no_draw_days: %q", day.Date)
+			continue
+		}
+		noDrawDays = append(noDrawDays, lottery.NoDrawDay{Date: date, Name: day.Name})
+	}
+
+	var specialDraws []lottery.SpecialDrawDate
+	for _, special := range cfg.SpecialDraws {
+		date, err := time.ParseInLocation("2006-01-02", special.Date, lottery.SaoPaulo)
+		if err != nil {
+			logs.LogData("⚠️ Data inválida em app.calendar.special_draws: %q", special.Date)
+			continue
+		}
+		ltype, err := lottery.ParseLotteryType(special.Lottery)
+		if err != nil {
+			logs.LogData("⚠️ Loteria inválida em app.calendar.special_draws: %q", special.Lottery)
+			continue
+		}
+		specialDraws = append(specialDraws, lottery.SpecialDrawDate{Lottery: ltype, Name: special.Name, Date: date})
 	}
+
+	lottery.SetCalendarOverrides(noDrawDays, specialDraws)
+	for _, special := range specialDraws {
+		// Só a Mega da Virada é sorteada fora dos dias regulares; os demais apenas nomeiam o sorteio do dia
+		if _, name := lottery.DrawOn(special.Lottery, special.Date); name != special.Name {
+			logs.LogData("⚠️ %s em %s não cai em dia de sorteio de %s e foi ignorado", special.Name, special.Date.Format("02/01/2006"), special.Lottery)
+		}
+	}
+	if len(noDrawDays) > 0 || len(specialDraws) > 0 {
+		logs.LogData("📅 Calendário: %d dias sem sorteio e %d concursos especiais da configuração", len(noDrawDays), len(specialDraws))
+	}
+}
+
+// DataMode retorna o modo de dados em uso (live, record ou replay)
+func (c *Client) DataMode() string {
+	return c.mode
 }
 
 // GetLatestDraws busca os últimos sorteios de uma loteria com sistema de cache
 func (c *Client) GetLatestDraws(ltype lottery.LotteryType, count int) ([]lottery.Draw, error) {
+	if c.store != nil {
+		return c.syncLatestDraws(ltype, count)
+	}
+
 	// Tentar buscar da API primeiro
 	draws, err := c.fetchFromAPI(ltype, count)
 
@@ -81,149 +168,288 @@ func (c *Client) GetLatestDraws(ltype lottery.LotteryType, count int) ([]lottery
 	return nil, fmt.Errorf("API da CAIXA indisponível e cache não encontrado ou expirado")
 }
 
-// fetchFromAPI busca dados diretamente da API
-func (c *Client) fetchFromAPI(ltype lottery.LotteryType, count int) ([]lottery.Draw, error) {
-	endpoint := fmt.Sprintf("%s/%s/", c.baseURL, string(ltype))
+// syncLatestDraws lê os sorteios do banco local, buscando na API só os concursos que faltam
+func (c *Client) syncLatestDraws(ltype lottery.LotteryType, count int) ([]lottery.Draw, error) {
+	stored, err := c.store.LatestDraws(ltype, count)
+	if err != nil {
+		logs.LogError(logs.CategoryData, "Erro ao ler sorteios guardados de %s: %v", ltype, err)
+	}
 
-	var draws []lottery.Draw
+	// O último sorteio guardado informa a data do próximo; antes dela não há nada novo na API
+	if len(stored) >= count && !isStale(stored[0]) {
+		if config.IsVerbose() {
+			logs.LogData("📦 %d sorteios de %s lidos do banco local", len(stored), ltype)
+		}
+		return stored, nil
+	}
 
-	// Delay inicial para não parecer bot
-	time.Sleep(1 * time.Second)
+	fetched, err := c.fetchMissingDraws(ltype, count)
+	if err != nil {
+		logs.LogData("⚠️ API falhou para %s: %v", ltype, err)
 
-	// Buscar o último sorteio primeiro para descobrir o número atual
-	latestResp, err := c.client.R().Get(endpoint)
+		if len(stored) == 0 {
+			stored = c.seedFromCache(ltype, count)
+		}
+		if len(stored) > 0 {
+			logs.LogData("📦 Usando %d sorteios guardados para %s", len(stored), ltype)
+			return stored, nil
+		}
+		return nil, fmt.Errorf("API da CAIXA indisponível e nenhum sorteio guardado para %s", ltype)
+	}
+
+	if _, err := c.store.SaveDraws(ltype, fetched); err != nil {
+		logs.LogError(logs.CategoryData, "Erro ao guardar sorteios de %s: %v", ltype, err)
+		return fetched, nil
+	}
 
+	draws, err := c.store.LatestDraws(ltype, count)
 	if err != nil {
-		logs.LogError(logs.CategoryData, "Erro de conectividade: %v", err)
-		return nil, fmt.Errorf("erro de conectividade: %w", err)
+		return nil, err
 	}
+	logs.LogData("✅ %s sincronizada: %d sorteios novos, %d disponíveis", ltype, len(fetched), len(draws))
+	return draws, nil
+}
 
-	if latestResp.StatusCode() == 403 {
-		logs.LogError(logs.CategoryData, "API da CAIXA bloqueada (erro 403)")
-		return nil, fmt.Errorf("API da CAIXA bloqueada (erro 403)")
+// fetchMissingDraws busca o último sorteio e os concursos da janela que ainda não estão guardados
+func (c *Client) fetchMissingDraws(ltype lottery.LotteryType, count int) ([]lottery.Draw, error) {
+	latest, err := c.fetchLatestDraw(ltype)
+	if err != nil {
+		return nil, err
 	}
 
-	if latestResp.StatusCode() != 200 {
-		logs.LogError(logs.CategoryData, "API retornou status %d", latestResp.StatusCode())
-		return nil, fmt.Errorf("API retornou status %d", latestResp.StatusCode())
+	draws := []lottery.Draw{*latest}
+
+	first := latest.Number - count + 1
+	if first < 1 {
+		first = 1
+	}
+	storedNumbers, err := c.store.StoredNumbers(ltype, first, latest.Number)
+	if err != nil {
+		return nil, err
 	}
 
-	// Debug: Log da resposta
-	if config.IsVerbose() {
-		logs.LogData("🔍 Debug %s: Status=%d, Content-Type=%s", ltype, latestResp.StatusCode(), latestResp.Header().Get("Content-Type"))
-		logs.LogData("🔍 Debug %s: Primeiros 200 chars da resposta: %s", ltype, string(latestResp.Body()[:min(200, len(latestResp.Body()))]))
+	var missing []int
+	for number := latest.Number - 1; number >= first; number-- {
+		if !storedNumbers[number] {
+			missing = append(missing, number)
+		}
 	}
 
-	var latest lottery.Draw
-	if err := json.Unmarshal(latestResp.Body(), &latest); err != nil {
-		logs.LogError(logs.CategoryData, "Erro ao decodificar resposta da API: %v", err)
-		return nil, fmt.Errorf("erro ao decodificar resposta da API: %w", err)
+	for _, result := range c.fetchContests(context.Background(), ltype, missing) {
+		if result.Err != nil {
+			logs.LogData("⚠️ Sorteio %d de %s não pôde ser baixado: %v", result.Number, ltype, result.Err)
+			continue
+		}
+		draws = append(draws, *result.Draw)
 	}
 
-	draws = append(draws, latest)
+	return draws, nil
+}
 
-	// Buscar sorteios anteriores com delay entre requisições
-	for i := 1; i < count && latest.Number-i > 0; i++ {
-		// Delay para não sobrecarregar a API
-		time.Sleep(500 * time.Millisecond)
+// seedFromCache importa para o banco local os sorteios do cache JSON antigo, se houver
+func (c *Client) seedFromCache(ltype lottery.LotteryType, count int) []lottery.Draw {
+	cachedDraws, hasCachedData := c.cacheManager.LoadFromCache(ltype, count)
+	if !hasCachedData {
+		return nil
+	}
 
-		drawNumber := latest.Number - i
-		drawResp, err := c.client.R().Get(fmt.Sprintf("%s%d", endpoint, drawNumber))
+	if _, err := c.store.SaveDraws(ltype, cachedDraws); err != nil {
+		logs.LogError(logs.CategoryData, "Erro ao importar cache de %s: %v", ltype, err)
+	}
+	return cachedDraws
+}
 
-		if err != nil || drawResp.StatusCode() != 200 {
-			if config.IsVerbose() {
-				logs.LogData("Erro ao buscar sorteio %d, parando busca: %v", drawNumber, err)
-			}
-			break
-		}
+// isStale indica se já pode haver sorteio mais novo que o informado
+// Um resultado manual também conta, para o oficial ser buscado e substituí-lo
+func isStale(latest lottery.Draw) bool {
+	next := latest.NextDrawDate.Time()
+	return latest.Manual || next.IsZero() || !next.After(time.Now())
+}
 
-		var draw lottery.Draw
-		if err := json.Unmarshal(drawResp.Body(), &draw); err != nil {
+// fetchFromAPI busca dados diretamente da API
+func (c *Client) fetchFromAPI(ltype lottery.LotteryType, count int) ([]lottery.Draw, error) {
+	latest, err := c.fetchLatestDraw(ltype)
+	if err != nil {
+		return nil, err
+	}
+
+	draws := []lottery.Draw{*latest}
+
+	// Buscar sorteios anteriores em paralelo, já ordenados do mais novo para o mais antigo
+	var numbers []int
+	for i := 1; i < count && latest.Number-i > 0; i++ {
+		numbers = append(numbers, latest.Number-i)
+	}
+
+	var failed []int
+	for _, result := range c.fetchContests(context.Background(), ltype, numbers) {
+		if result.Err != nil {
 			if config.IsVerbose() {
-				logs.LogData("Erro ao decodificar sorteio %d: %v", drawNumber, err)
+				logs.LogData("Erro ao buscar sorteio %d: %v", result.Number, result.Err)
 			}
+			failed = append(failed, result.Number)
 			continue
 		}
+		draws = append(draws, *result.Draw)
+	}
 
-		draws = append(draws, draw)
+	// Concursos que falharam ficam de fora; a auditoria (RepairDraws) os busca de novo
+	if len(failed) > 0 {
+		logs.LogError(logs.CategoryData, "%d sorteios de %s não puderam ser baixados: %v", len(failed), ltype, failed)
 	}
 
 	logs.LogData("✅ Fetched %d draws for %s from API", len(draws), ltype)
 	return draws, nil
 }
 
-func min(a, b int) int {
-	if a < b {
-		return a
+// fetchLatestDraw busca o sorteio mais recente para descobrir o número atual
+func (c *Client) fetchLatestDraw(ltype lottery.LotteryType) (*lottery.Draw, error) {
+	// Passa pelo mesmo limite de requisições dos concursos, para buscas em paralelo não parecerem bot
+	// (desnecessário ao reproduzir fixtures)
+	if c.mode != DataModeReplay {
+		if err := c.limiter.Wait(context.Background()); err != nil {
+			return nil, err
+		}
 	}
-	return b
+
+	latest, err := c.sources.Latest(ltype)
+	if err != nil {
+		logs.LogError(logs.CategoryData, "Erro ao buscar último sorteio de %s: %v", ltype, err)
+		return nil, err
+	}
+
+	if config.IsVerbose() {
+		logs.LogData("🔍 Debug %s: último concurso %d", ltype, latest.Number)
+	}
+	return latest, nil
+}
+
+// fetchContest busca um concurso específico nas fontes de sorteios
+func (c *Client) fetchContest(ltype lottery.LotteryType, number int) (*lottery.Draw, error) {
+	draw, err := c.sources.Contest(ltype, number)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar sorteio %d: %w", number, err)
+	}
+	return draw, nil
+}
+
+// SourceStatus retorna a saúde de cada fonte de sorteios configurada
+func (c *Client) SourceStatus() []SourceStatus {
+	return c.sources.Status()
 }
 
 // GetDrawByNumber busca um sorteio específico pelo número
+// Sorteios já apurados vêm do banco local; os demais são buscados na API e guardados
+// Um resultado manual guardado só é usado enquanto a API não tiver o oficial
 func (c *Client) GetDrawByNumber(ltype lottery.LotteryType, number int) (*lottery.Draw, error) {
-	endpoint := fmt.Sprintf("%s/%s/%d", c.baseURL, string(ltype), number)
-
-	resp, err := c.client.R().
-		SetHeader("Accept", "application/json").
-		Get(endpoint)
+	var manual *lottery.Draw
+	if c.store != nil {
+		stored, err := c.store.GetDraw(ltype, number)
+		if err != nil {
+			logs.LogError(logs.CategoryData, "Erro ao ler sorteio %d guardado: %v", number, err)
+		}
+		if stored != nil && !stored.Manual {
+			return stored, nil
+		}
+		manual = stored
+	}
 
+	draw, err := c.fetchContest(ltype, number)
 	if err != nil {
-		return nil, fmt.Errorf("erro ao buscar sorteio %d: %w", number, err)
+		if manual != nil {
+			logs.LogData("✍️ Usando resultado manual de %s %d: %v", ltype, number, err)
+			return manual, nil
+		}
+		return nil, err
 	}
 
-	if resp.StatusCode() != 200 {
-		return nil, fmt.Errorf("API retornou status %d para sorteio %d", resp.StatusCode(), number)
+	if c.store != nil {
+		if _, err := c.store.SaveDraws(ltype, []lottery.Draw{*draw}); err != nil {
+			logs.LogError(logs.CategoryData, "Erro ao guardar sorteio %d: %v", number, err)
+		}
+	}
+
+	return draw, nil
+}
+
+// GetLotecaFixtures busca a grade de partidas de um concurso da Loteca
+// Partidas ainda não apuradas vêm sem placar (LotecaMatch.Outcome retorna vazio)
+func (c *Client) GetLotecaFixtures(contestNumber int) ([]lottery.LotecaMatch, error) {
+	draw, err := c.GetDrawByNumber(lottery.Loteca, contestNumber)
+	if err != nil {
+		logs.LogError(logs.CategoryData, "Erro ao buscar grade da Loteca %d: %v", contestNumber, err)
+		return nil, err
 	}
 
-	var draw lottery.Draw
-	if err := json.Unmarshal(resp.Body(), &draw); err != nil {
-		return nil, fmt.Errorf("erro ao decodificar sorteio %d: %w", number, err)
+	rules := lottery.GetRules(lottery.Loteca)
+	if len(draw.Matches) != rules.Matches {
+		return nil, fmt.Errorf("grade da Loteca %d incompleta: %d de %d partidas", contestNumber, len(draw.Matches), rules.Matches)
 	}
 
-	return &draw, nil
+	logs.LogData("⚽ Grade da Loteca %d carregada: %d partidas", contestNumber, len(draw.Matches))
+	return draw.Matches, nil
 }
 
 // GetDrawsRange busca sorteios em um intervalo
 func (c *Client) GetDrawsRange(ltype lottery.LotteryType, startNumber, endNumber int) ([]lottery.Draw, error) {
 	var draws []lottery.Draw
+	var missing []int
 
 	for number := startNumber; number <= endNumber; number++ {
-		draw, err := c.GetDrawByNumber(ltype, number)
-		if err != nil {
+		if c.store != nil {
+			if stored, err := c.store.GetDraw(ltype, number); err == nil && stored != nil {
+				draws = append(draws, *stored)
+				continue
+			}
+		}
+		missing = append(missing, number)
+	}
+
+	var fetched []lottery.Draw
+	for _, result := range c.fetchContests(context.Background(), ltype, missing) {
+		if result.Err != nil {
 			if config.IsVerbose() {
-				logs.LogData("Erro ao buscar sorteio %d: %v", number, err)
+				logs.LogData("Erro ao buscar sorteio %d: %v", result.Number, result.Err)
 			}
 			continue
 		}
-		draws = append(draws, *draw)
+		fetched = append(fetched, *result.Draw)
+	}
 
-		// Pequeno delay para não sobrecarregar a API
-		time.Sleep(100 * time.Millisecond)
+	if c.store != nil {
+		if _, err := c.store.SaveDraws(ltype, fetched); err != nil {
+			logs.LogError(logs.CategoryData, "Erro ao guardar sorteios de %s: %v", ltype, err)
+		}
 	}
 
+	draws = append(draws, fetched...)
+	sort.Slice(draws, func(i, j int) bool {
+		return draws[i].Number < draws[j].Number
+	})
+
 	logs.LogData("✅ Fetched %d draws in range %d-%d for %s", len(draws), startNumber, endNumber, ltype)
 	return draws, nil
 }
 
-// GetAllHistoricalDraws busca todo o histórico disponível (usar com cuidado)
-func (c *Client) GetAllHistoricalDraws(ltype lottery.LotteryType) ([]lottery.Draw, error) {
-	// Primeiro, buscar o último sorteio para saber quantos existem
-	latest, err := c.GetLatestDraws(ltype, 1)
-	if err != nil {
-		logs.LogError(logs.CategoryData, "Erro ao buscar último sorteio: %v", err)
-		return nil, fmt.Errorf("erro ao buscar último sorteio: %w", err)
-	}
-
-	if len(latest) == 0 {
-		logs.LogError(logs.CategoryData, "Nenhum sorteio encontrado")
-		return nil, fmt.Errorf("nenhum sorteio encontrado")
+// GetHistory retorna todo o histórico guardado no banco local, do concurso mais novo para o mais antigo
+// Não acessa a rede: o histórico completo é baixado pelo backfill. Sem banco local, ou com menos de
+// minimum sorteios guardados, busca os últimos minimum sorteios como GetLatestDraws
+func (c *Client) GetHistory(ltype lottery.LotteryType, minimum int) ([]lottery.Draw, error) {
+	if c.store != nil {
+		draws, err := c.store.AllDraws(ltype)
+		if err != nil {
+			logs.LogError(logs.CategoryData, "Erro ao ler histórico guardado de %s: %v", ltype, err)
+		}
+		if err == nil && len(draws) >= minimum {
+			// AllDraws vem do mais antigo para o mais novo
+			for i, j := 0, len(draws)-1; i < j; i, j = i+1, j-1 {
+				draws[i], draws[j] = draws[j], draws[i]
+			}
+			return draws, nil
+		}
 	}
 
-	latestNumber := latest[0].Number
-	logs.LogData("🔍 Buscando histórico completo de %s até sorteio %d", ltype, latestNumber)
-
-	// Buscar todos os sorteios do 1 até o último
-	return c.GetDrawsRange(ltype, 1, latestNumber)
+	return c.GetLatestDraws(ltype, minimum)
 }
 
 // TestConnection testa se a API está respondendo
@@ -251,20 +477,48 @@ func (c *Client) TestConnection() error {
 
 // GetNextDrawInfo busca informações sobre o próximo sorteio
 func (c *Client) GetNextDrawInfo(ltype lottery.LotteryType) (time.Time, int, error) {
-	draws, err := c.GetLatestDraws(ltype, 1)
+	contests, err := c.UpcomingContests(ltype, 1)
 	if err != nil {
-		logs.LogError(logs.CategoryData, "Erro ao buscar próximo sorteio para %s: %v", ltype, err)
 		return time.Time{}, 0, err
 	}
 
+	next := contests[0]
+	logs.LogData("🔍 Próximo sorteio %s: %d em %s", ltype, next.Number, next.Date.Format("02/01/2006"))
+	return next.Date, next.Number, nil
+}
+
+// UpcomingContests projeta os próximos count concursos pelo calendário da loteria
+// Parte do último sorteio guardado no banco local, sem acessar a rede: o calendário avança a numeração
+// mesmo com os dados desatualizados. A API (ou o cache) só é consultada quando não há sorteio guardado
+func (c *Client) UpcomingContests(ltype lottery.LotteryType, count int) ([]lottery.ScheduledContest, error) {
+	var draws []lottery.Draw
+	if c.store != nil {
+		stored, err := c.store.LatestDraws(ltype, 1)
+		if err != nil {
+			logs.LogError(logs.CategoryData, "Erro ao ler último sorteio guardado de %s: %v", ltype, err)
+		}
+		draws = stored
+	}
+
+	if len(draws) == 0 {
+		fetched, err := c.GetLatestDraws(ltype, 1)
+		if err != nil {
+			logs.LogError(logs.CategoryData, "Erro ao buscar próximo sorteio para %s: %v", ltype, err)
+			return nil, err
+		}
+		draws = fetched
+	}
+
 	if len(draws) == 0 {
 		logs.LogError(logs.CategoryData, "Nenhum sorteio encontrado para %s", ltype)
-		return time.Time{}, 0, fmt.Errorf("nenhum sorteio encontrado")
+		return nil, fmt.Errorf("nenhum sorteio encontrado")
 	}
 
-	latest := draws[0]
-	logs.LogData("🔍 Próximo sorteio %s: %d em %s", ltype, latest.NextDrawNumber, time.Time(latest.NextDrawDate).Format("02/01/2006"))
-	return time.Time(latest.NextDrawDate), latest.NextDrawNumber, nil
+	contests := lottery.UpcomingContests(ltype, draws[0], time.Now(), count)
+	if len(contests) == 0 {
+		return nil, fmt.Errorf("calendário de %s sem sorteios futuros", ltype)
+	}
+	return contests, nil
 }
 
 // TestDirectAPI testa diretamente a API para debug
@@ -0,0 +1,224 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"lottery-optimizer-gui/internal/config"
+	"lottery-optimizer-gui/internal/logs"
+	"lottery-optimizer-gui/internal/lottery"
+	"sync"
+	"time"
+)
+
+// Limites da busca concorrente de concursos
+const (
+	defaultFetchConcurrency = 4
+	defaultFetchRate        = 2.0 // Requisições por segundo
+	minFetchRate            = 0.2 // Ritmo mínimo após sucessivos bloqueios
+	maxFetchAttempts        = 3   // Tentativas por concurso antes de desistir dele
+	minThrottleCooldown     = 2 * time.Second
+	maxThrottleCooldown     = 60 * time.Second
+)
+
+// contestResult é o resultado da busca de um concurso
+type contestResult struct {
+	Number int
+	Draw   *lottery.Draw
+	Err    error
+}
+
+// isThrottled indica se a API recusou a requisição por bloqueio (403) ou excesso de requisições (429)
+func isThrottled(err error) bool {
+	return errors.Is(err, ErrBlocked) || errors.Is(err, ErrRateLimited)
+}
+
+// fetchContests busca os concursos em paralelo, respeitando o limite de requisições do cliente
+// Os resultados voltam na mesma ordem de numbers; um concurso com erro é tentado de novo
+// individualmente e, se esgotar as tentativas, volta com o erro sem interromper os demais.
+// Bloqueios da API reduzem o ritmo de todas as requisições pelo rate limiter; só o cancelamento
+// de ctx encerra a busca antes do fim
+func (c *Client) fetchContests(ctx context.Context, ltype lottery.LotteryType, numbers []int) []contestResult {
+	results := make([]contestResult, len(numbers))
+	if len(numbers) == 0 {
+		return results
+	}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+
+	workers := c.concurrency
+	if workers > len(numbers) {
+		workers = len(numbers)
+	}
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				draw, err := c.fetchContestWithRetry(ctx, ltype, numbers[i])
+				results[i] = contestResult{Number: numbers[i], Draw: draw, Err: err}
+			}
+		}()
+	}
+
+	for i := range numbers {
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+			results[i] = contestResult{Number: numbers[i], Err: context.Cause(ctx)}
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results
+}
+
+// fetchContestWithRetry busca um concurso, tentando de novo com backoff em caso de erro
+func (c *Client) fetchContestWithRetry(ctx context.Context, ltype lottery.LotteryType, number int) (*lottery.Draw, error) {
+	var lastErr error
+	for attempt := 1; attempt <= maxFetchAttempts; attempt++ {
+		if err := c.limiter.Wait(ctx); err != nil {
+			return nil, context.Cause(ctx)
+		}
+
+		draw, err := c.fetchContest(ltype, number)
+		if err == nil {
+			c.limiter.Succeeded()
+			return draw, nil
+		}
+		lastErr = err
+
+		if isThrottled(err) {
+			cooldown := c.limiter.Throttled()
+			logs.LogData("⛔ %s %d: %v, reduzindo ritmo e aguardando %s", ltype, number, err, cooldown)
+			continue
+		}
+
+		if config.IsVerbose() {
+			logs.LogData("⚠️ Tentativa %d/%d do sorteio %d falhou: %v", attempt, maxFetchAttempts, number, err)
+		}
+		if !sleepContext(ctx, time.Duration(attempt)*time.Second) {
+			return nil, context.Cause(ctx)
+		}
+	}
+	return nil, lastErr
+}
+
+// sleepContext espera d ou até o contexto ser cancelado; retorna false se foi cancelado
+func sleepContext(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// rateLimiter é um token bucket com backoff adaptativo
+// Cada bloqueio da API pausa todas as requisições e reduz o ritmo pela metade; cada
+// sucesso devolve parte do ritmo até voltar ao configurado
+type rateLimiter struct {
+	mu          sync.Mutex
+	baseRate    float64 // Ritmo configurado (tokens por segundo)
+	rate        float64 // Ritmo atual
+	burst       float64
+	tokens      float64
+	last        time.Time
+	pausedUntil time.Time
+	cooldown    time.Duration
+}
+
+// newRateLimiter cria um token bucket com o ritmo e a rajada informados
+func newRateLimiter(rate float64, burst int) *rateLimiter {
+	if rate <= 0 {
+		rate = defaultFetchRate
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	return &rateLimiter{
+		baseRate: rate,
+		rate:     rate,
+		burst:    float64(burst),
+		tokens:   float64(burst),
+		last:     time.Now(),
+	}
+}
+
+// Wait bloqueia até haver um token disponível ou o contexto ser cancelado
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+		now := time.Now()
+
+		if now.Before(l.pausedUntil) {
+			wait := l.pausedUntil.Sub(now)
+			l.mu.Unlock()
+			if !sleepContext(ctx, wait) {
+				return ctx.Err()
+			}
+			continue
+		}
+
+		l.tokens += now.Sub(l.last).Seconds() * l.rate
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+		l.last = now
+
+		if l.tokens >= 1 {
+			l.tokens--
+			l.mu.Unlock()
+			return nil
+		}
+
+		wait := time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+		l.mu.Unlock()
+		if !sleepContext(ctx, wait) {
+			return ctx.Err()
+		}
+	}
+}
+
+// Throttled registra um bloqueio da API: pausa as requisições e reduz o ritmo
+// Retorna a pausa aplicada, que dobra a cada bloqueio seguido
+func (l *rateLimiter) Throttled() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.cooldown == 0 {
+		l.cooldown = minThrottleCooldown
+	} else if l.cooldown < maxThrottleCooldown {
+		l.cooldown *= 2
+		if l.cooldown > maxThrottleCooldown {
+			l.cooldown = maxThrottleCooldown
+		}
+	}
+
+	l.rate /= 2
+	if l.rate < minFetchRate {
+		l.rate = minFetchRate
+	}
+	l.tokens = 0
+	l.pausedUntil = time.Now().Add(l.cooldown)
+	return l.cooldown
+}
+
+// Succeeded registra uma requisição bem-sucedida e recupera aos poucos o ritmo configurado
+func (l *rateLimiter) Succeeded() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.cooldown = 0
+	if l.rate < l.baseRate {
+		l.rate += l.baseRate / 10
+		if l.rate > l.baseRate {
+			l.rate = l.baseRate
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterThrottled(t *testing.T) {
+	tests := []struct {
+		name         string
+		baseRate     float64
+		throttles    int
+		wantCooldown time.Duration
+		wantRate     float64
+	}{
+		{"primeiro bloqueio", 2, 1, 2 * time.Second, 1},
+		{"bloqueios seguidos dobram a pausa", 2, 3, 8 * time.Second, 0.25},
+		{"ritmo não cai abaixo do mínimo", 2, 4, 16 * time.Second, minFetchRate},
+		{"pausa limitada ao máximo", 2, 8, maxThrottleCooldown, minFetchRate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter := newRateLimiter(tt.baseRate, 4)
+
+			var cooldown time.Duration
+			for i := 0; i < tt.throttles; i++ {
+				cooldown = limiter.Throttled()
+			}
+
+			if cooldown != tt.wantCooldown {
+				t.Errorf("pausa = %s, want %s", cooldown, tt.wantCooldown)
+			}
+			if limiter.rate != tt.wantRate {
+				t.Errorf("ritmo = %.2f, want %.2f", limiter.rate, tt.wantRate)
+			}
+			if limiter.tokens != 0 {
+				t.Errorf("tokens = %.2f, want 0 após bloqueio", limiter.tokens)
+			}
+			if !limiter.pausedUntil.After(time.Now()) {
+				t.Error("requisições não foram pausadas após o bloqueio")
+			}
+		})
+	}
+}
+
+func TestRateLimiterSucceeded(t *testing.T) {
+	tests := []struct {
+		name       string
+		throttles  int
+		successes  int
+		wantRate   float64
+		wantPaused time.Duration // Pausa do próximo bloqueio
+	}{
+		{"sucesso após um bloqueio", 1, 1, 1.2, minThrottleCooldown},
+		{"recupera aos poucos", 1, 3, 1.6, minThrottleCooldown},
+		{"não passa do ritmo configurado", 1, 20, 2, minThrottleCooldown},
+		{"sem bloqueio mantém o ritmo", 0, 5, 2, minThrottleCooldown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter := newRateLimiter(2, 4)
+			for i := 0; i < tt.throttles; i++ {
+				limiter.Throttled()
+			}
+			for i := 0; i < tt.successes; i++ {
+				limiter.Succeeded()
+			}
+
+			if diff := limiter.rate - tt.wantRate; diff > 1e-9 || diff < -1e-9 {
+				t.Errorf("ritmo = %.2f, want %.2f", limiter.rate, tt.wantRate)
+			}
+			// Um sucesso zera a sequência de bloqueios: o próximo volta à pausa mínima
+			if cooldown := limiter.Throttled(); cooldown != tt.wantPaused {
+				t.Errorf("pausa do próximo bloqueio = %s, want %s", cooldown, tt.wantPaused)
+			}
+		})
+	}
+}
+
+func TestRateLimiterWait(t *testing.T) {
+	t.Run("rajada inicial sem espera", func(t *testing.T) {
+		limiter := newRateLimiter(1, 3)
+		start := time.Now()
+		for i := 0; i < 3; i++ {
+			if err := limiter.Wait(context.Background()); err != nil {
+				t.Fatalf("Wait: %v", err)
+			}
+		}
+		if elapsed := time.Since(start); elapsed > 100*time.Millisecond {
+			t.Errorf("rajada levou %s, want imediata", elapsed)
+		}
+	})
+
+	t.Run("pausa após bloqueio respeita o cancelamento", func(t *testing.T) {
+		limiter := newRateLimiter(2, 4)
+		limiter.Throttled()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		err := limiter.Wait(ctx)
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("Wait() error = %v, want %v", err, context.DeadlineExceeded)
+		}
+		if elapsed := time.Since(start); elapsed >= minThrottleCooldown {
+			t.Errorf("Wait esperou a pausa inteira (%s) em vez de parar no cancelamento", elapsed)
+		}
+	})
+}
@@ -0,0 +1,504 @@
+package data
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"html"
+	"io"
+	"lottery-optimizer-gui/internal/logs"
+	"lottery-optimizer-gui/internal/lottery"
+	"math"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ImportResult resume a importação de uma planilha de resultados da CAIXA
+type ImportResult struct {
+	LotteryType  string   `json:"lottery_type"`
+	File         string   `json:"file"`
+	Format       string   `json:"format"` // "xlsx" ou "html"
+	Imported     int      `json:"imported"`
+	Skipped      int      `json:"skipped"` // Linhas que não são concursos (ex: cidades dos ganhadores)
+	FirstContest int      `json:"first_contest"`
+	LastContest  int      `json:"last_contest"`
+	Warnings     []string `json:"warnings,omitempty"`
+}
+
+// ImportResultsFile importa a planilha oficial de resultados (XLSX ou HTML) para o banco local
+// Sem banco local, os sorteios são mesclados ao cache JSON
+func (c *Client) ImportResultsFile(ltype lottery.LotteryType, path string) (*ImportResult, error) {
+	draws, result, err := ParseResultsFile(ltype, path)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.store != nil {
+		if _, err := c.store.SaveDraws(ltype, draws); err != nil {
+			return nil, fmt.Errorf("erro ao guardar sorteios importados: %w", err)
+		}
+	} else if err := c.mergeIntoCache(ltype, draws); err != nil {
+		return nil, err
+	}
+
+	logs.LogData("📥 Importados %d sorteios de %s (%d a %d) de %s",
+		result.Imported, ltype, result.FirstContest, result.LastContest, filepath.Base(path))
+	return result, nil
+}
+
+// mergeIntoCache junta os sorteios importados aos do cache, do mais novo para o mais antigo
+func (c *Client) mergeIntoCache(ltype lottery.LotteryType, draws []lottery.Draw) error {
+	byNumber := make(map[int]lottery.Draw)
+	if cached, ok := c.cacheManager.LoadFromCache(ltype, math.MaxInt); ok {
+		for _, draw := range cached {
+			byNumber[draw.Number] = draw
+		}
+	}
+	for _, draw := range draws {
+		byNumber[draw.Number] = draw
+	}
+
+	merged := make([]lottery.Draw, 0, len(byNumber))
+	for _, draw := range byNumber {
+		merged = append(merged, draw)
+	}
+	sort.Slice(merged, func(i, j int) bool {
+		return merged[i].Number > merged[j].Number
+	})
+
+	if err := c.cacheManager.SaveToCache(ltype, merged, len(merged)); err != nil {
+		return fmt.Errorf("erro ao salvar sorteios importados no cache: %w", err)
+	}
+	return nil
+}
+
+// ParseResultsFile lê a planilha de resultados da CAIXA de uma loteria
+// As colunas são reconhecidas pelo cabeçalho, então a ordem e as colunas extras não importam
+func ParseResultsFile(ltype lottery.LotteryType, path string) ([]lottery.Draw, *ImportResult, error) {
+	if lottery.GetRules(ltype).IsMatchPool() {
+		return nil, nil, fmt.Errorf("importação de planilha não suportada para %s", ltype)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("erro ao ler planilha: %w", err)
+	}
+
+	result := &ImportResult{LotteryType: string(ltype), File: path}
+
+	var rows [][]string
+	if bytes.HasPrefix(content, []byte("PK")) {
+		result.Format = "xlsx"
+		rows, err = readXLSXRows(content)
+	} else {
+		result.Format = "html"
+		rows = readHTMLRows(decodeLatin1(content))
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	draws, err := parseResultRows(ltype, rows, result)
+	if err != nil {
+		return nil, nil, err
+	}
+	return draws, result, nil
+}
+
+// Tipos de coluna da planilha de resultados
+const (
+	colIgnored = iota
+	colContest
+	colDate
+	colNumber
+	colSecondNumber
+	colSecondary
+	colExtraPick
+	colWinners
+	colPrize
+	colAccumulated
+	colCollected
+)
+
+// resultColumn descreve o que uma coluna da planilha contém
+type resultColumn struct {
+	kind int
+	tier string // Faixa de prêmio (colunas de ganhadores e rateio)
+}
+
+var (
+	secondDrawHeader = regexp.MustCompile(`(2|segundo)\S*\s*sorteio|sorteio\s*2`)
+	numberHeader     = regexp.MustCompile(`\b(bola|dezena|coluna)\s*\d*\b`)
+)
+
+// classifyHeader identifica a coluna pelo texto do cabeçalho
+func classifyHeader(header string) resultColumn {
+	h := foldHeader(header)
+
+	switch {
+	case h == "concurso":
+		return resultColumn{kind: colContest}
+	case strings.HasPrefix(h, "data"):
+		return resultColumn{kind: colDate}
+	case strings.HasPrefix(h, "ganhadores"):
+		return resultColumn{kind: colWinners, tier: strings.TrimSpace(strings.TrimPrefix(h, "ganhadores"))}
+	case strings.HasPrefix(h, "rateio"):
+		return resultColumn{kind: colPrize, tier: strings.TrimSpace(strings.TrimPrefix(h, "rateio"))}
+	case strings.HasPrefix(h, "acumulado"):
+		// Acumulados de sorteios especiais (Mega da Virada, Independência...) não indicam o concurso atual
+		if strings.Contains(h, "especial") || strings.Contains(h, "virada") {
+			return resultColumn{kind: colIgnored}
+		}
+		return resultColumn{kind: colAccumulated}
+	case strings.HasPrefix(h, "arrecadacao"):
+		return resultColumn{kind: colCollected}
+	case strings.HasPrefix(h, "trevo"):
+		return resultColumn{kind: colSecondary}
+	case strings.HasPrefix(h, "time") || strings.HasPrefix(h, "mes"):
+		return resultColumn{kind: colExtraPick}
+	case numberHeader.MatchString(h):
+		if secondDrawHeader.MatchString(h) {
+			return resultColumn{kind: colSecondNumber}
+		}
+		return resultColumn{kind: colNumber}
+	}
+	return resultColumn{kind: colIgnored}
+}
+
+// foldHeader normaliza o cabeçalho: minúsculas, sem acentos e sem espaços repetidos
+func foldHeader(header string) string {
+	folded := strings.NewReplacer(
+		"á", "a", "à", "a", "â", "a", "ã", "a",
+		"é", "e", "ê", "e", "í", "i",
+		"ó", "o", "ô", "o", "õ", "o", "ú", "u", "ç", "c",
+	).Replace(strings.ToLower(header))
+	return strings.Join(strings.Fields(folded), " ")
+}
+
+// parseResultRows converte as linhas da planilha em sorteios
+// Linhas sem número de concurso (ex: cidades extras dos ganhadores) são puladas
+func parseResultRows(ltype lottery.LotteryType, rows [][]string, result *ImportResult) ([]lottery.Draw, error) {
+	headerRow := -1
+	for i, row := range rows {
+		if len(row) > 0 && foldHeader(row[0]) == "concurso" {
+			headerRow = i
+			break
+		}
+	}
+	if headerRow < 0 {
+		return nil, fmt.Errorf("cabeçalho da planilha não encontrado (esperada a coluna \"Concurso\")")
+	}
+
+	columns := make([]resultColumn, len(rows[headerRow]))
+	var tiers []string
+	hasNumbers := false
+	for i, header := range rows[headerRow] {
+		columns[i] = classifyHeader(header)
+		switch columns[i].kind {
+		case colNumber:
+			hasNumbers = true
+		case colWinners:
+			tiers = append(tiers, columns[i].tier)
+		}
+	}
+	if !hasNumbers {
+		return nil, fmt.Errorf("colunas das dezenas não encontradas na planilha")
+	}
+
+	// As faixas aparecem da principal para a menor, na mesma ordem do índice "faixa" da API
+	tierIndex := make(map[string]int, len(tiers))
+	for i, tier := range tiers {
+		tierIndex[tier] = i + 1
+	}
+
+	rules := lottery.GetRules(ltype)
+	var draws []lottery.Draw
+
+	for _, row := range rows[headerRow+1:] {
+		draw, ok := parseResultRow(row, columns, tierIndex)
+		if !ok {
+			result.Skipped++
+			continue
+		}
+		draw.Normalize(ltype)
+
+		if rules.ResultNumbers > 0 && len(draw.Numbers) != rules.ResultNumbers {
+			result.Warnings = append(result.Warnings,
+				fmt.Sprintf("concurso %d: %d dezenas (esperadas %d)", draw.Number, len(draw.Numbers), rules.ResultNumbers))
+		}
+
+		draws = append(draws, draw)
+		if result.FirstContest == 0 || draw.Number < result.FirstContest {
+			result.FirstContest = draw.Number
+		}
+		if draw.Number > result.LastContest {
+			result.LastContest = draw.Number
+		}
+	}
+
+	if len(draws) == 0 {
+		return nil, fmt.Errorf("nenhum concurso encontrado na planilha")
+	}
+
+	result.Imported = len(draws)
+	return draws, nil
+}
+
+// parseResultRow converte uma linha da planilha em sorteio
+func parseResultRow(row []string, columns []resultColumn, tierIndex map[string]int) (lottery.Draw, bool) {
+	var draw lottery.Draw
+	winners := make(map[int]*lottery.Winner)
+
+	winner := func(tier string) *lottery.Winner {
+		index := tierIndex[tier]
+		if winners[index] == nil {
+			winners[index] = &lottery.Winner{Tier: index, Description: tier}
+		}
+		return winners[index]
+	}
+
+	for i, column := range columns {
+		if i >= len(row) {
+			break
+		}
+		cell := strings.TrimSpace(row[i])
+
+		switch column.kind {
+		case colContest:
+			number, err := strconv.Atoi(cell)
+			if err != nil || number <= 0 {
+				return draw, false
+			}
+			draw.Number = number
+		case colDate:
+			date, ok := parseSheetDate(cell)
+			if !ok {
+				return draw, false
+			}
+			draw.Date = lottery.BrazilianDate(date)
+		case colNumber, colSecondNumber, colSecondary:
+			if cell == "" {
+				continue
+			}
+			number, err := strconv.Atoi(cell)
+			if err != nil {
+				return draw, false
+			}
+			switch column.kind {
+			case colNumber:
+				draw.Numbers = append(draw.Numbers, number)
+			case colSecondNumber:
+				draw.SecondNumbers = append(draw.SecondNumbers, number)
+			default:
+				draw.Secondary = append(draw.Secondary, number)
+			}
+		case colExtraPick:
+			draw.ExtraPick = cell
+		case colWinners:
+			count, _ := strconv.Atoi(strings.ReplaceAll(cell, ".", ""))
+			winner(column.tier).Winners = count
+		case colPrize:
+			if _, ok := tierIndex[column.tier]; ok {
+				winner(column.tier).Prize = parseBRL(cell)
+			}
+		case colAccumulated:
+			folded := foldHeader(cell)
+			draw.Accumulated = folded == "sim" || parseBRL(cell) > 0
+		case colCollected:
+			draw.PrizeTotal = parseBRL(cell)
+		}
+	}
+
+	if draw.Number == 0 {
+		return draw, false
+	}
+
+	for index := 1; index <= len(tierIndex); index++ {
+		if w, ok := winners[index]; ok {
+			draw.Winners = append(draw.Winners, *w)
+		}
+	}
+	return draw, true
+}
+
+// parseSheetDate aceita "02/01/2006" ou o número serial de datas do Excel
+func parseSheetDate(cell string) (time.Time, bool) {
+	if date, err := time.Parse("02/01/2006", cell); err == nil {
+		return date, true
+	}
+	if serial, err := strconv.ParseFloat(cell, 64); err == nil && serial > 0 {
+		return time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC).AddDate(0, 0, int(serial)), true
+	}
+	return time.Time{}, false
+}
+
+// parseBRL converte valores como "R$ 1.234,56", "1234,56" ou "1234.56" (célula numérica)
+func parseBRL(cell string) float64 {
+	value := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(cell), "R$"))
+	if strings.Contains(value, ",") {
+		value = strings.ReplaceAll(value, ".", "")
+		value = strings.Replace(value, ",", ".", 1)
+	}
+	amount, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0
+	}
+	return amount
+}
+
+// readXLSXRows lê as linhas da primeira planilha de um arquivo XLSX
+func readXLSXRows(content []byte) ([][]string, error) {
+	archive, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return nil, fmt.Errorf("arquivo XLSX inválido: %w", err)
+	}
+
+	var sharedStrings []string
+	var sheet *zip.File
+	for _, file := range archive.File {
+		switch {
+		case file.Name == "xl/sharedStrings.xml":
+			if sharedStrings, err = readSharedStrings(file); err != nil {
+				return nil, err
+			}
+		case strings.HasPrefix(file.Name, "xl/worksheets/sheet") && strings.HasSuffix(file.Name, ".xml"):
+			if sheet == nil || file.Name < sheet.Name {
+				sheet = file
+			}
+		}
+	}
+	if sheet == nil {
+		return nil, fmt.Errorf("arquivo XLSX sem planilhas")
+	}
+
+	reader, err := sheet.Open()
+	if err != nil {
+		return nil, fmt.Errorf("erro ao abrir planilha: %w", err)
+	}
+	defer reader.Close()
+
+	var data struct {
+		Rows []struct {
+			Cells []struct {
+				Ref    string `xml:"r,attr"`
+				Type   string `xml:"t,attr"`
+				Value  string `xml:"v"`
+				Inline string `xml:"is>t"`
+			} `xml:"c"`
+		} `xml:"sheetData>row"`
+	}
+	if err := xml.NewDecoder(reader).Decode(&data); err != nil {
+		return nil, fmt.Errorf("erro ao ler planilha: %w", err)
+	}
+
+	rows := make([][]string, 0, len(data.Rows))
+	for _, xmlRow := range data.Rows {
+		var row []string
+		for i, cell := range xmlRow.Cells {
+			col := columnIndex(cell.Ref)
+			if col < 0 {
+				col = i
+			}
+			for len(row) <= col {
+				row = append(row, "")
+			}
+
+			switch cell.Type {
+			case "s":
+				index, err := strconv.Atoi(cell.Value)
+				if err == nil && index >= 0 && index < len(sharedStrings) {
+					row[col] = sharedStrings[index]
+				}
+			case "inlineStr":
+				row[col] = cell.Inline
+			default:
+				row[col] = cell.Value
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// readSharedStrings lê a tabela de textos compartilhados do XLSX
+func readSharedStrings(file *zip.File) ([]string, error) {
+	reader, err := file.Open()
+	if err != nil {
+		return nil, fmt.Errorf("erro ao abrir textos da planilha: %w", err)
+	}
+	defer reader.Close()
+
+	var data struct {
+		Items []struct {
+			Text string   `xml:"t"`
+			Runs []string `xml:"r>t"`
+		} `xml:"si"`
+	}
+	if err := xml.NewDecoder(reader).Decode(&data); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("erro ao ler textos da planilha: %w", err)
+	}
+
+	strs := make([]string, len(data.Items))
+	for i, item := range data.Items {
+		strs[i] = item.Text + strings.Join(item.Runs, "")
+	}
+	return strs, nil
+}
+
+// columnIndex converte a referência da célula (ex: "C12") no índice da coluna (2)
+func columnIndex(ref string) int {
+	col := 0
+	letters := 0
+	for _, r := range ref {
+		if r < 'A' || r > 'Z' {
+			break
+		}
+		col = col*26 + int(r-'A'+1)
+		letters++
+	}
+	if letters == 0 {
+		return -1
+	}
+	return col - 1
+}
+
+// decodeLatin1 converte para UTF-8 os arquivos HTML antigos da CAIXA, salvos em ISO-8859-1
+func decodeLatin1(content []byte) string {
+	if utf8.Valid(content) {
+		return string(content)
+	}
+	runes := make([]rune, len(content))
+	for i, b := range content {
+		runes[i] = rune(b)
+	}
+	return string(runes)
+}
+
+var (
+	htmlRowPattern  = regexp.MustCompile(`(?is)<tr[^>]*>(.*?)</tr>`)
+	htmlCellPattern = regexp.MustCompile(`(?is)<t[dh][^>]*>(.*?)</t[dh]>`)
+	htmlTagPattern  = regexp.MustCompile(`(?s)<[^>]*>`)
+)
+
+// readHTMLRows lê as linhas das tabelas de um arquivo HTML de resultados
+func readHTMLRows(content string) [][]string {
+	var rows [][]string
+	for _, rowMatch := range htmlRowPattern.FindAllStringSubmatch(content, -1) {
+		var row []string
+		for _, cellMatch := range htmlCellPattern.FindAllStringSubmatch(rowMatch[1], -1) {
+			text := html.UnescapeString(htmlTagPattern.ReplaceAllString(cellMatch[1], " "))
+			row = append(row, strings.Join(strings.Fields(text), " "))
+		}
+		if len(row) > 0 {
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
@@ -0,0 +1,271 @@
+package data
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"lottery-optimizer-gui/internal/lottery"
+)
+
+// writeXLSX monta um XLSX mínimo: a primeira linha vai para os textos compartilhados e as demais
+// são células numéricas, com uma célula de texto embutido (inlineStr) na última coluna
+func writeXLSX(t *testing.T, rows [][]string) string {
+	t.Helper()
+
+	var shared, sheet strings.Builder
+	shared.WriteString(`<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">`)
+	sheet.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+	for r, row := range rows {
+		fmt.Fprintf(&sheet, `<row r="%d">`, r+1)
+		for c, cell := range row {
+			ref := fmt.Sprintf("%c%d", 'A'+c, r+1)
+			switch {
+			case cell == "":
+				continue
+			case r == 0:
+				fmt.Fprintf(&shared, `<si><t>%s</t></si>`, cell)
+				fmt.Fprintf(&sheet, `<c r="%s" t="s"><v>%d</v></c>`, ref, c)
+			case c == len(row)-1:
+				fmt.Fprintf(&sheet, `<c r="%s" t="inlineStr"><is><t>%s</t></is></c>`, ref, cell)
+			default:
+				fmt.Fprintf(&sheet, `<c r="%s"><v>%s</v></c>`, ref, cell)
+			}
+		}
+		sheet.WriteString(`</row>`)
+	}
+	shared.WriteString(`</sst>`)
+	sheet.WriteString(`</sheetData></worksheet>`)
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	for name, content := range map[string]string{
+		"xl/sharedStrings.xml":     shared.String(),
+		"xl/worksheets/sheet1.xml": sheet.String(),
+	} {
+		file, err := archive.Create(name)
+		if err != nil {
+			t.Fatalf("zip.Create(%s): %v", name, err)
+		}
+		if _, err := file.Write([]byte(content)); err != nil {
+			t.Fatalf("zip.Write(%s): %v", name, err)
+		}
+	}
+	if err := archive.Close(); err != nil {
+		t.Fatalf("zip.Close: %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "resultados.xlsx")
+	if err := os.WriteFile(path, buf.Bytes(), 0644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	return path
+}
+
+func TestParseResultsFileXLSX(t *testing.T) {
+	header := []string{"Concurso", "Data Sorteio"}
+	for i := 1; i <= 15; i++ {
+		header = append(header, fmt.Sprintf("Bola%d", i))
+	}
+	header = append(header, "Ganhadores 15 acertos", "Rateio 15 acertos", "Acumulado 15 acertos")
+
+	row := func(contest, date string, winners, prize, accumulated string) []string {
+		cells := []string{contest, date}
+		for i := 1; i <= 15; i++ {
+			cells = append(cells, fmt.Sprintf("%d", i*5/3+1))
+		}
+		return append(cells, winners, prize, accumulated)
+	}
+
+	path := writeXLSX(t, [][]string{
+		header,
+		row("3200", "45537", "2", "1500000.5", "0"),
+		row("3201", "45538", "0", "0", "R$ 2.000.000,00"),
+	})
+
+	draws, result, err := ParseResultsFile(lottery.Lotofacil, path)
+	if err != nil {
+		t.Fatalf("ParseResultsFile() error = %v", err)
+	}
+	if result.Format != "xlsx" || result.Imported != 2 || result.FirstContest != 3200 || result.LastContest != 3201 {
+		t.Errorf("ImportResult = %+v", result)
+	}
+	if len(result.Warnings) != 0 {
+		t.Errorf("Warnings = %v, want nenhum", result.Warnings)
+	}
+
+	first := draws[0]
+	if len(first.Numbers) != 15 {
+		t.Errorf("concurso %d: %d dezenas, want 15", first.Number, len(first.Numbers))
+	}
+	if got := first.Date.Time().Format("2006-01-02"); got != "2024-09-02" {
+		t.Errorf("Date = %s, want 2024-09-02", got)
+	}
+	wantWinners := []lottery.Winner{{Tier: 1, Description: "15 acertos", Winners: 2, Prize: 1500000.5}}
+	if !reflect.DeepEqual(first.Winners, wantWinners) {
+		t.Errorf("Winners = %+v, want %+v", first.Winners, wantWinners)
+	}
+	if first.Accumulated || !draws[1].Accumulated {
+		t.Errorf("Accumulated = %v, %v, want false, true", first.Accumulated, draws[1].Accumulated)
+	}
+}
+
+func TestParseResultsFileLatin1HTML(t *testing.T) {
+	// Arquivo antigo da CAIXA: ISO-8859-1, com linhas extras de cidades dos ganhadores
+	content := "<html><body><table>" +
+		"<tr><th>Concurso</th><th>Data do Sorteio</th><th>1\xaa Dezena</th><th>2\xaa Dezena</th>" +
+		"<th>3\xaa Dezena</th><th>4\xaa Dezena</th><th>5\xaa Dezena</th><th>6\xaa Dezena</th>" +
+		"<th>Arrecada\xe7\xe3o Total</th><th>Ganhadores Sena</th><th>Rateio Sena</th>" +
+		"<th>Ganhadores Quina</th><th>Rateio Quina</th></tr>" +
+		"<tr><td>2800</td><td>10/10/2024</td><td>01</td><td>12</td><td>23</td><td>34</td><td>45</td><td>56</td>" +
+		"<td>R$ 80.000.000,00</td><td>1</td><td>R$ 50.000.000,00</td><td>35</td><td>R$ 52.123,45</td></tr>" +
+		"<tr><td>S\xc3O PAULO</td><td>SP</td></tr>" +
+		"<tr><td>2801</td><td>12/10/2024</td><td>02</td><td>13</td><td>24</td><td>35</td><td>46</td>" +
+		"<td>57</td><td>R$ 60.000.000,00</td><td>0</td><td>R$ 0,00</td><td>40</td><td>R$ 40.000,00</td></tr>" +
+		"</table></body></html>"
+	path := filepath.Join(t.TempDir(), "resultados.htm")
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	draws, result, err := ParseResultsFile(lottery.MegaSena, path)
+	if err != nil {
+		t.Fatalf("ParseResultsFile() error = %v", err)
+	}
+	if result.Format != "html" || result.Imported != 2 || result.Skipped != 1 {
+		t.Errorf("ImportResult = %+v", result)
+	}
+
+	first := draws[0]
+	if got := first.Numbers.ToIntSlice(); !reflect.DeepEqual(got, []int{1, 12, 23, 34, 45, 56}) {
+		t.Errorf("Numbers = %v", got)
+	}
+	if first.PrizeTotal != 80000000 {
+		t.Errorf("PrizeTotal = %.2f, want 80000000", first.PrizeTotal)
+	}
+	wantWinners := []lottery.Winner{
+		{Tier: 1, Description: "sena", Winners: 1, Prize: 50000000},
+		{Tier: 2, Description: "quina", Winners: 35, Prize: 52123.45},
+	}
+	if !reflect.DeepEqual(first.Winners, wantWinners) {
+		t.Errorf("Winners = %+v, want %+v", first.Winners, wantWinners)
+	}
+}
+
+func TestParseResultsFileWarnsOnWrongCount(t *testing.T) {
+	path := writeXLSX(t, [][]string{
+		{"Concurso", "Data", "Bola1", "Bola2", "Bola3", "Bola4", "Bola5", "Arrecadacao"},
+		{"2800", "10/10/2024", "1", "2", "3", "4", "5", "0"},
+	})
+
+	_, result, err := ParseResultsFile(lottery.MegaSena, path)
+	if err != nil {
+		t.Fatalf("ParseResultsFile() error = %v", err)
+	}
+	if len(result.Warnings) != 1 || !strings.Contains(result.Warnings[0], "5 dezenas (esperadas 6)") {
+		t.Errorf("Warnings = %v", result.Warnings)
+	}
+}
+
+func TestClassifyHeader(t *testing.T) {
+	tests := []struct {
+		header string
+		want   resultColumn
+	}{
+		{"Concurso", resultColumn{kind: colContest}},
+		{"Data Sorteio", resultColumn{kind: colDate}},
+		{"Bola1", resultColumn{kind: colNumber}},
+		{"1ª Dezena", resultColumn{kind: colNumber}},
+		{"Coluna 3", resultColumn{kind: colNumber}},
+		{"1ª Dezena 2º Sorteio", resultColumn{kind: colSecondNumber}},
+		{"Dezena 3 Sorteio 2", resultColumn{kind: colSecondNumber}},
+		{"Trevo 1", resultColumn{kind: colSecondary}},
+		{"Time de Coração", resultColumn{kind: colExtraPick}},
+		{"Mês da Sorte", resultColumn{kind: colExtraPick}},
+		{"Ganhadores 6 acertos", resultColumn{kind: colWinners, tier: "6 acertos"}},
+		{"Rateio  6 acertos", resultColumn{kind: colPrize, tier: "6 acertos"}},
+		{"Acumulado 6 acertos", resultColumn{kind: colAccumulated}},
+		{"Acumulado Sorteio Especial Mega da Virada", resultColumn{kind: colIgnored}},
+		{"Arrecadação Total", resultColumn{kind: colCollected}},
+		{"Cidade / UF", resultColumn{kind: colIgnored}},
+	}
+
+	for _, tt := range tests {
+		if got := classifyHeader(tt.header); got != tt.want {
+			t.Errorf("classifyHeader(%q) = %+v, want %+v", tt.header, got, tt.want)
+		}
+	}
+}
+
+func TestParseBRL(t *testing.T) {
+	tests := []struct {
+		cell string
+		want float64
+	}{
+		{"R$ 1.234,56", 1234.56},
+		{"R$1.000.000,00", 1000000},
+		{"1234,56", 1234.56},
+		{"1234.56", 1234.56},
+		{" 0 ", 0},
+		{"", 0},
+		{"-", 0},
+	}
+
+	for _, tt := range tests {
+		if got := parseBRL(tt.cell); got != tt.want {
+			t.Errorf("parseBRL(%q) = %v, want %v", tt.cell, got, tt.want)
+		}
+	}
+}
+
+func TestParseSheetDate(t *testing.T) {
+	tests := []struct {
+		cell   string
+		want   string
+		wantOK bool
+	}{
+		{"02/09/2024", "2024-09-02", true},
+		{"45537", "2024-09-02", true},
+		{"45537.5", "2024-09-02", true},
+		{"2024-09-02", "", false},
+		{"", "", false},
+	}
+
+	for _, tt := range tests {
+		got, ok := parseSheetDate(tt.cell)
+		if ok != tt.wantOK {
+			t.Errorf("parseSheetDate(%q) ok = %v, want %v", tt.cell, ok, tt.wantOK)
+			continue
+		}
+		if ok && got.Format(time.DateOnly) != tt.want {
+			t.Errorf("parseSheetDate(%q) = %s, want %s", tt.cell, got.Format(time.DateOnly), tt.want)
+		}
+	}
+}
+
+func TestColumnIndex(t *testing.T) {
+	tests := []struct {
+		ref  string
+		want int
+	}{
+		{"A1", 0},
+		{"C12", 2},
+		{"Z3", 25},
+		{"AA1", 26},
+		{"AB10", 27},
+		{"1", -1},
+		{"", -1},
+	}
+
+	for _, tt := range tests {
+		if got := columnIndex(tt.ref); got != tt.want {
+			t.Errorf("columnIndex(%q) = %d, want %d", tt.ref, got, tt.want)
+		}
+	}
+}
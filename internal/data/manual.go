@@ -0,0 +1,529 @@
+package data
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"lottery-optimizer-gui/internal/logs"
+	"lottery-optimizer-gui/internal/lottery"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DrawDiscrepancy é uma diferença entre o resultado manual e o oficial
+type DrawDiscrepancy struct {
+	Field    string `json:"field"`
+	Manual   string `json:"manual"`
+	Official string `json:"official"`
+}
+
+// DrawCorrection registra a substituição de um resultado manual pelo oficial
+type DrawCorrection struct {
+	ID            int64             `json:"id"`
+	LotteryType   string            `json:"lottery_type"`
+	Number        int               `json:"number"`
+	Discrepancies []DrawDiscrepancy `json:"discrepancies"`
+	ReplacedAt    time.Time         `json:"replaced_at"`
+	Applied       bool              `json:"applied"` // Jogos conferidos com o resultado manual já foram reabertos
+}
+
+// ValidateManualDraw confere um resultado informado pelo usuário contra as regras da loteria
+// Também normaliza o sorteio: palpite extra na grafia oficial, descrição das faixas e acumulado
+func ValidateManualDraw(ltype lottery.LotteryType, draw *lottery.Draw) error {
+	rules := lottery.GetRules(ltype)
+	if rules.IsMatchPool() {
+		return fmt.Errorf("resultado manual não suportado para %s", rules.Name)
+	}
+
+	if draw.Number <= 0 {
+		return fmt.Errorf("número do concurso inválido: %d", draw.Number)
+	}
+	if draw.Date.Time().IsZero() {
+		return fmt.Errorf("data do sorteio é obrigatória")
+	}
+	if draw.Date.Time().After(time.Now().AddDate(0, 0, 1)) {
+		return fmt.Errorf("data do sorteio no futuro: %s", draw.Date)
+	}
+
+	draw.Normalize(ltype)
+	if issues := validateDraw(ltype, *draw); len(issues) > 0 {
+		details := make([]string, len(issues))
+		for i, issue := range issues {
+			details[i] = issue.Detail
+		}
+		return fmt.Errorf("resultado inválido: %s", strings.Join(details, "; "))
+	}
+
+	if rules.HasExtraPick() {
+		value, err := lottery.NormalizeExtraPick(ltype, draw.ExtraPick)
+		if err != nil {
+			return err
+		}
+		draw.ExtraPick = value
+	}
+
+	tiers := make(map[int]lottery.PrizeTier, len(rules.PrizeTiers))
+	for _, tier := range rules.PrizeTiers {
+		tiers[tier.Faixa] = tier
+	}
+	seen := make(map[int]bool)
+	for i := range draw.Winners {
+		winner := &draw.Winners[i]
+		tier, ok := tiers[winner.Tier]
+		if !ok {
+			return fmt.Errorf("faixa %d não existe em %s", winner.Tier, rules.Name)
+		}
+		if seen[winner.Tier] {
+			return fmt.Errorf("faixa %d informada mais de uma vez", winner.Tier)
+		}
+		seen[winner.Tier] = true
+
+		if winner.Winners < 0 || winner.Prize < 0 {
+			return fmt.Errorf("faixa %d: ganhadores e prêmio não podem ser negativos", winner.Tier)
+		}
+		if winner.Description == "" {
+			winner.Description = tier.Name
+		}
+		if winner.Tier == 1 {
+			draw.Accumulated = winner.Winners == 0
+		}
+	}
+	sort.Slice(draw.Winners, func(i, j int) bool { return draw.Winners[i].Tier < draw.Winners[j].Tier })
+
+	return nil
+}
+
+// SaveManualDraw guarda um resultado informado pelo usuário (ex: API fora do ar na noite do sorteio)
+// O sorteio fica marcado como manual e é substituído pelo oficial assim que a API responder
+func (c *Client) SaveManualDraw(ltype lottery.LotteryType, draw lottery.Draw) (*lottery.Draw, error) {
+	if err := ValidateManualDraw(ltype, &draw); err != nil {
+		return nil, err
+	}
+	draw.Manual = true
+
+	if c.store != nil {
+		existing, err := c.store.GetDraw(ltype, draw.Number)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil && !existing.Manual {
+			return nil, fmt.Errorf("concurso %d já tem o resultado oficial", draw.Number)
+		}
+		if _, err := c.store.SaveDraws(ltype, []lottery.Draw{draw}); err != nil {
+			return nil, fmt.Errorf("erro ao guardar resultado manual: %w", err)
+		}
+	} else {
+		if cached, ok := c.cacheManager.LoadFromCache(ltype, math.MaxInt); ok {
+			for _, existing := range cached {
+				if existing.Number == draw.Number && !existing.Manual {
+					return nil, fmt.Errorf("concurso %d já tem o resultado oficial", draw.Number)
+				}
+			}
+		}
+		if err := c.mergeIntoCache(ltype, []lottery.Draw{draw}); err != nil {
+			return nil, err
+		}
+	}
+
+	logs.LogData("✍️ Resultado manual de %s %d guardado: %v", ltype, draw.Number, draw.Numbers)
+	return &draw, nil
+}
+
+// DrawCorrections lista os resultados manuais já substituídos pelo oficial, do mais recente ao mais antigo
+// Com ltype vazio, lista todas as loterias; com pendingOnly, só os que ainda não foram aplicados aos jogos
+func (c *Client) DrawCorrections(ltype lottery.LotteryType, pendingOnly bool) ([]DrawCorrection, error) {
+	if c.store == nil {
+		return nil, nil
+	}
+	return c.store.Corrections(ltype, pendingOnly)
+}
+
+// MarkCorrectionApplied registra que os jogos afetados pela correção já foram reabertos
+func (c *Client) MarkCorrectionApplied(id int64) error {
+	if c.store == nil {
+		return nil
+	}
+	return c.store.MarkCorrectionApplied(id)
+}
+
+// storedDraw lê o sorteio guardado dentro da transação (nil se não existir)
+func storedDraw(tx *sql.Tx, ltype lottery.LotteryType, number int) (*lottery.Draw, error) {
+	var drawJSON string
+	var manual int
+	err := tx.QueryRow("SELECT draw_json, manual FROM draws WHERE lottery_type = ? AND number = ?", string(ltype), number).Scan(&drawJSON, &manual)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar sorteio %d guardado: %w", number, err)
+	}
+
+	var draw lottery.Draw
+	if err := json.Unmarshal([]byte(drawJSON), &draw); err != nil {
+		return nil, fmt.Errorf("erro ao decodificar sorteio %d guardado: %w", number, err)
+	}
+	draw.Manual = manual == 1
+	return &draw, nil
+}
+
+// recordCorrection registra a substituição do resultado manual pelo oficial com as diferenças
+func recordCorrection(tx *sql.Tx, ltype lottery.LotteryType, manual, official lottery.Draw, now time.Time) error {
+	discrepancies := compareDraws(ltype, manual, official)
+	data, err := json.Marshal(discrepancies)
+	if err != nil {
+		return fmt.Errorf("erro ao serializar diferenças do sorteio %d: %w", official.Number, err)
+	}
+
+	_, err = tx.Exec(`
+		INSERT INTO draw_corrections (lottery_type, number, discrepancies, replaced_at)
+		VALUES (?, ?, ?, ?)
+	`, string(ltype), official.Number, string(data), now)
+	if err != nil {
+		return fmt.Errorf("erro ao registrar correção do sorteio %d: %w", official.Number, err)
+	}
+
+	if len(discrepancies) > 0 {
+		logs.LogData("⚠️ Resultado manual de %s %d substituído pelo oficial com %d diferenças", ltype, official.Number, len(discrepancies))
+		for _, d := range discrepancies {
+			logs.LogData("   • %s: manual %s, oficial %s", d.Field, d.Manual, d.Official)
+		}
+	} else {
+		logs.LogData("✅ Resultado manual de %s %d confirmado pelo oficial", ltype, official.Number)
+	}
+	return nil
+}
+
+// compareDraws lista as diferenças entre o resultado manual e o oficial
+// Só as faixas informadas no resultado manual são comparadas
+func compareDraws(ltype lottery.LotteryType, manual, official lottery.Draw) []DrawDiscrepancy {
+	rules := lottery.GetRules(ltype)
+	discrepancies := []DrawDiscrepancy{}
+	add := func(field string, manualValue, officialValue interface{}) {
+		discrepancies = append(discrepancies, DrawDiscrepancy{
+			Field:    field,
+			Manual:   fmt.Sprint(manualValue),
+			Official: fmt.Sprint(officialValue),
+		})
+	}
+
+	if !manual.Date.Time().Equal(official.Date.Time()) {
+		add("data", manual.Date, official.Date)
+	}
+
+	// Dezenas são comparadas como conjunto; na Super Sete a ordem das colunas importa
+	sameNumbers := func(a, b []int) bool {
+		if !rules.IsPositional() {
+			a, b = sortedInts(a), sortedInts(b)
+		}
+		return fmt.Sprint(a) == fmt.Sprint(b)
+	}
+	if !sameNumbers(manual.Numbers, official.Numbers) {
+		add("dezenas", manual.Numbers.ToIntSlice(), official.Numbers.ToIntSlice())
+	}
+	if len(manual.SecondNumbers) > 0 && !sameNumbers(manual.SecondNumbers, official.SecondNumbers) {
+		add("dezenas do 2º sorteio", manual.SecondNumbers.ToIntSlice(), official.SecondNumbers.ToIntSlice())
+	}
+	if len(manual.Secondary) > 0 && fmt.Sprint(sortedInts(manual.Secondary)) != fmt.Sprint(sortedInts(official.Secondary)) {
+		add(rules.SecondaryName, manual.Secondary.ToIntSlice(), official.Secondary.ToIntSlice())
+	}
+	if manual.ExtraPick != "" && !lottery.MatchExtraPick(manual.ExtraPick, official.ExtraPick) {
+		add(rules.ExtraPickName, manual.ExtraPick, official.ExtraPick)
+	}
+
+	officialTiers := make(map[int]lottery.Winner, len(official.Winners))
+	for i, winner := range official.Winners {
+		tier := winner.Tier
+		if tier == 0 {
+			tier = i + 1
+		}
+		officialTiers[tier] = winner
+	}
+	for _, winner := range manual.Winners {
+		officialWinner, ok := officialTiers[winner.Tier]
+		if !ok {
+			continue
+		}
+		if winner.Winners != officialWinner.Winners {
+			add(fmt.Sprintf("ganhadores da faixa %d", winner.Tier), winner.Winners, officialWinner.Winners)
+		}
+		if math.Abs(winner.Prize-officialWinner.Prize) >= 0.01 {
+			add(fmt.Sprintf("prêmio da faixa %d", winner.Tier),
+				fmt.Sprintf("R$ %.2f", winner.Prize), fmt.Sprintf("R$ %.2f", officialWinner.Prize))
+		}
+	}
+
+	return discrepancies
+}
+
+// sortedInts retorna uma cópia ordenada das dezenas
+func sortedInts(numbers []int) []int {
+	sorted := append([]int(nil), numbers...)
+	sort.Ints(sorted)
+	return sorted
+}
+
+// Corrections lista as correções registradas (ltype vazio = todas as loterias)
+func (s *DrawStore) Corrections(ltype lottery.LotteryType, pendingOnly bool) ([]DrawCorrection, error) {
+	query := "SELECT id, lottery_type, number, discrepancies, replaced_at, applied FROM draw_corrections WHERE 1 = 1"
+	var args []interface{}
+	if ltype != "" {
+		query += " AND lottery_type = ?"
+		args = append(args, string(ltype))
+	}
+	if pendingOnly {
+		query += " AND applied = 0"
+	}
+	query += " ORDER BY replaced_at DESC, id DESC"
+
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar correções de sorteios: %w", err)
+	}
+	defer rows.Close()
+
+	var corrections []DrawCorrection
+	for rows.Next() {
+		var correction DrawCorrection
+		var discrepancies string
+		var applied int
+		if err := rows.Scan(&correction.ID, &correction.LotteryType, &correction.Number, &discrepancies, &correction.ReplacedAt, &applied); err != nil {
+			return nil, fmt.Errorf("erro ao fazer scan da correção: %w", err)
+		}
+		if err := json.Unmarshal([]byte(discrepancies), &correction.Discrepancies); err != nil {
+			return nil, fmt.Errorf("erro ao decodificar diferenças da correção %d: %w", correction.ID, err)
+		}
+		correction.Applied = applied == 1
+		corrections = append(corrections, correction)
+	}
+	return corrections, rows.Err()
+}
+
+// MarkCorrectionApplied marca a correção como aplicada aos jogos conferidos
+func (s *DrawStore) MarkCorrectionApplied(id int64) error {
+	if _, err := s.db.Exec("UPDATE draw_corrections SET applied = 1 WHERE id = ?", id); err != nil {
+		return fmt.Errorf("erro ao marcar correção %d: %w", id, err)
+	}
+	return nil
+}
+
+// Expressões do texto colado do site da CAIXA (ex: "Concurso 2800 (10/10/2024)")
+var (
+	manualContestPattern = regexp.MustCompile(`(?i)concurso\s*(?:n[º°o.]*\s*)?(\d+)`)
+	manualDatePattern    = regexp.MustCompile(`\d{1,2}/\d{1,2}/\d{4}`)
+	manualNumberPattern  = regexp.MustCompile(`\b\d{1,3}\b`)
+	manualWinnersPattern = regexp.MustCompile(`(?i)([\d.]+)\s*(?:apostas?\s+ganhadoras?|ganhador(?:es)?|acertador(?:es)?)`)
+	manualPrizePattern   = regexp.MustCompile(`R\$\s*([\d.,]+)`)
+	manualTierPattern    = regexp.MustCompile(`(?i)\b(\d+)\s*(acertos?|pontos?|colunas?)\b`)
+	manualNumbersLine    = regexp.MustCompile(`^[\d\s,;.|/-]+$`)
+	manualIgnoredLine    = regexp.MustCompile(`estimativa|acumul|arrecada|proximo concurso`) // Valores que não são rateio
+)
+
+// ParseManualDraw lê um resultado colado do site da CAIXA ou de outra fonte, como:
+//
+//	Concurso 2800 (10/10/2024)
+//	01 - 12 - 23 - 34 - 45 - 56
+//	6 acertos
+//	Não houve acertador
+//	5 acertos
+//	35 apostas ganhadoras, R$ 52.123,45
+//
+// O resultado lido deve ser revisado pelo usuário e gravado com SaveManualDraw
+func ParseManualDraw(ltype lottery.LotteryType, text string) (*lottery.Draw, error) {
+	rules := lottery.GetRules(ltype)
+	if rules.IsMatchPool() {
+		return nil, fmt.Errorf("resultado manual não suportado para %s", rules.Name)
+	}
+
+	draw := &lottery.Draw{}
+	if match := manualContestPattern.FindStringSubmatch(text); match != nil {
+		draw.Number, _ = strconv.Atoi(match[1])
+	} else {
+		return nil, fmt.Errorf("número do concurso não encontrado (ex: \"Concurso 2800\")")
+	}
+	if match := manualDatePattern.FindString(text); match != "" {
+		var date lottery.BrazilianDate
+		if err := date.UnmarshalJSON([]byte(`"` + match + `"`)); err == nil {
+			draw.Date = date
+		}
+	}
+
+	numberSets, setSize := 1, rules.ResultNumbers
+	if rules.HasMultipleDraws() {
+		numberSets = rules.DrawsPerContest
+	}
+	if rules.IsPositional() {
+		setSize = rules.Columns
+	}
+
+	var sets [][]int
+	expectExtraPick, expectSecondary := false, false
+	for _, raw := range strings.Split(text, "\n") {
+		line := strings.TrimSpace(raw)
+		if line == "" || manualContestPattern.MatchString(line) {
+			continue
+		}
+		folded := foldHeader(line)
+		if manualIgnoredLine.MatchString(folded) {
+			continue
+		}
+
+		// Cada linha com "N acertos" (ou o nome de uma faixa) abre uma nova faixa; ganhadores e rateio
+		// podem vir na mesma linha ou nas seguintes
+		tier, found, err := manualTier(rules, line, draw.Winners)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			draw.Winners = append(draw.Winners, lottery.Winner{Tier: tier.Faixa})
+		}
+		if len(draw.Winners) > 0 {
+			winner := &draw.Winners[len(draw.Winners)-1]
+			if match := manualWinnersPattern.FindStringSubmatch(line); match != nil {
+				winner.Winners, _ = strconv.Atoi(strings.ReplaceAll(match[1], ".", ""))
+			}
+			if match := manualPrizePattern.FindStringSubmatch(line); match != nil {
+				winner.Prize = parseBRL(match[1])
+			}
+			continue
+		}
+
+		// Antes das faixas: dezenas, trevos e palpite extra, com ou sem rótulo ("Trevos: 2 - 5")
+		value := line
+		if idx := strings.LastIndex(line, ":"); idx >= 0 {
+			value = strings.TrimSpace(line[idx+1:])
+		}
+
+		switch {
+		case rules.HasExtraPick() && strings.Contains(folded, foldHeader(rules.ExtraPickName)):
+			draw.ExtraPick = value
+			expectExtraPick = value == "" || value == line
+			if expectExtraPick {
+				draw.ExtraPick = ""
+			}
+		case expectExtraPick:
+			draw.ExtraPick = line
+			expectExtraPick = false
+		case rules.HasSecondary() && strings.Contains(folded, foldHeader(rules.SecondaryName)):
+			draw.Secondary = parseInts(value)
+			expectSecondary = len(draw.Secondary) == 0
+		case expectSecondary:
+			draw.Secondary = parseInts(line)
+			expectSecondary = false
+		case manualDatePattern.MatchString(line):
+			continue
+		case manualNumbersLine.MatchString(value):
+			// Dezenas podem vir quebradas em várias linhas (ex: as 20 da Lotomania)
+			for _, num := range parseInts(value) {
+				if len(sets) == 0 || len(sets[len(sets)-1]) >= setSize {
+					if len(sets) >= numberSets {
+						break
+					}
+					sets = append(sets, nil)
+				}
+				sets[len(sets)-1] = append(sets[len(sets)-1], num)
+			}
+		}
+	}
+
+	if len(sets) == 0 {
+		return nil, fmt.Errorf("dezenas sorteadas não encontradas")
+	}
+	draw.Numbers = sets[0]
+	if len(sets) > 1 {
+		draw.SecondNumbers = sets[1]
+	}
+
+	return draw, nil
+}
+
+// manualTier identifica a faixa aberta pela linha do resultado colado
+// "N acertos" vai para a faixa com esse número de acertos (e de trevos, se informado); contagens que a
+// loteria não premia são rejeitadas. Sem contagem, vale o nome da faixa (ex: "Mês da Sorte")
+// Faixas com os mesmos acertos (ex: os dois sorteios da Dupla Sena) são preenchidas na ordem
+func manualTier(rules lottery.LotteryRules, line string, assigned []lottery.Winner) (lottery.PrizeTier, bool, error) {
+	used := make(map[int]bool, len(assigned))
+	for _, winner := range assigned {
+		used[winner.Tier] = true
+	}
+	folded := foldHeader(line)
+
+	match := manualTierPattern.FindStringSubmatchIndex(line)
+	if match == nil {
+		// O palpite extra antes das faixas é o resultado sorteado, não a faixa (ex: "Mês da Sorte: Março")
+		for _, tier := range rules.PrizeTiers {
+			if !used[tier.Faixa] && (!tier.ExtraPick || len(assigned) > 0) && strings.Contains(folded, foldHeader(tier.Name)) {
+				return tier, true, nil
+			}
+		}
+		return lottery.PrizeTier{}, false, nil
+	}
+
+	hits, _ := strconv.Atoi(line[match[2]:match[3]])
+	unit := strings.ToLower(line[match[4]:match[5]])
+	secondary := manualSecondaryHits(rules, line[match[1]:])
+
+	var candidates []lottery.PrizeTier
+	for _, tier := range rules.PrizeTiers {
+		if tier.ExtraPick || tier.Hits != hits {
+			continue
+		}
+		if secondary >= 0 && len(tier.Secondary) > 0 && !containsInt(tier.Secondary, secondary) {
+			continue
+		}
+		candidates = append(candidates, tier)
+	}
+	if len(candidates) == 0 {
+		return lottery.PrizeTier{}, false, fmt.Errorf("%s não tem faixa de %d %s", rules.Name, hits, unit)
+	}
+
+	for _, tier := range candidates {
+		if !used[tier.Faixa] {
+			return tier, true, nil
+		}
+	}
+	return lottery.PrizeTier{}, false, fmt.Errorf("faixa de %d %s repetida no resultado", hits, unit)
+}
+
+// manualSecondaryHits lê os acertos da seleção secundária informados junto com a faixa
+// (ex: "+ 2 trevos", "+ 1 ou nenhum trevo"); retorna -1 se a linha não informar
+func manualSecondaryHits(rules lottery.LotteryRules, rest string) int {
+	if !rules.HasSecondary() {
+		return -1
+	}
+	stem := regexp.QuoteMeta(strings.TrimSuffix(foldHeader(rules.SecondaryName), "s"))
+	pattern := regexp.MustCompile(`(\d+|nenhum)\s+(?:ou\s+nenhum\s+)?` + stem)
+	match := pattern.FindStringSubmatch(foldHeader(rest))
+	if match == nil {
+		return -1
+	}
+	if match[1] == "nenhum" {
+		return 0
+	}
+	n, _ := strconv.Atoi(match[1])
+	return n
+}
+
+// containsInt indica se o valor está na lista
+func containsInt(values []int, value int) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// parseInts extrai os números inteiros da linha
+func parseInts(line string) []int {
+	var numbers []int
+	for _, token := range manualNumberPattern.FindAllString(line, -1) {
+		if n, err := strconv.Atoi(token); err == nil {
+			numbers = append(numbers, n)
+		}
+	}
+	return numbers
+}
@@ -0,0 +1,225 @@
+package data
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"lottery-optimizer-gui/internal/lottery"
+)
+
+func TestParseManualDrawTiers(t *testing.T) {
+	tests := []struct {
+		name      string
+		ltype     lottery.LotteryType
+		text      string
+		want      []lottery.Winner
+		extraPick string
+		wantErr   string
+	}{
+		{
+			name:  "faixas em ordem",
+			ltype: lottery.MegaSena,
+			text: `Concurso 2800 (10/10/2024)
+01 - 12 - 23 - 34 - 45 - 56
+6 acertos
+Não houve acertador
+5 acertos
+35 apostas ganhadoras, R$ 52.123,45
+4 acertos
+2.345 apostas ganhadoras, R$ 1.234,56`,
+			want: []lottery.Winner{
+				{Tier: 1},
+				{Tier: 2, Winners: 35, Prize: 52123.45},
+				{Tier: 3, Winners: 2345, Prize: 1234.56},
+			},
+		},
+		{
+			name:  "faixas fora de ordem vão pelos acertos",
+			ltype: lottery.MegaSena,
+			text: `Concurso 2800
+01 02 03 04 05 06
+Quadra (4 acertos)
+2.345 apostas ganhadoras, R$ 1.234,56
+Sena (6 acertos)
+1 aposta ganhadora, R$ 50.000.000,00`,
+			want: []lottery.Winner{
+				{Tier: 3, Winners: 2345, Prize: 1234.56},
+				{Tier: 1, Winners: 1, Prize: 50000000},
+			},
+		},
+		{
+			name:  "faixa e rateio na mesma linha",
+			ltype: lottery.Quina,
+			text: `Concurso 6500
+05 15 25 35 45
+2 acertos - 40.000 apostas ganhadoras - R$ 3,50`,
+			want: []lottery.Winner{{Tier: 4, Winners: 40000, Prize: 3.5}},
+		},
+		{
+			name:  "acertos que a loteria não premia",
+			ltype: lottery.MegaSena,
+			text: `Concurso 2800
+01 02 03 04 05 06
+3 acertos
+10 apostas ganhadoras, R$ 5,00`,
+			wantErr: "não tem faixa de 3 acertos",
+		},
+		{
+			name:  "faixa repetida",
+			ltype: lottery.MegaSena,
+			text: `Concurso 2800
+01 02 03 04 05 06
+5 acertos
+5 acertos`,
+			wantErr: "repetida",
+		},
+		{
+			name:  "trevos escolhem a faixa da +Milionária",
+			ltype: lottery.MaisMilionaria,
+			text: `Concurso 190
+01 02 03 04 05 06
+Trevos: 2 - 5
+6 acertos + 1 ou nenhum trevo
+2 apostas ganhadoras, R$ 100.000,00
+3 acertos + 1 trevo
+500 apostas ganhadoras, R$ 24,00
+6 acertos + 2 trevos
+Não houve acertador`,
+			want: []lottery.Winner{
+				{Tier: 2, Winners: 2, Prize: 100000},
+				{Tier: 8, Winners: 500, Prize: 24},
+				{Tier: 1},
+			},
+		},
+		{
+			name:  "trevos que a faixa não premia",
+			ltype: lottery.MaisMilionaria,
+			text: `Concurso 190
+01 02 03 04 05 06
+Trevos: 2 - 5
+3 acertos + nenhum trevo`,
+			wantErr: "não tem faixa de 3 acertos",
+		},
+		{
+			name:  "15 dezenas da Lotofácil",
+			ltype: lottery.Lotofacil,
+			text: `Concurso 3200 (02/09/2024)
+01 02 04 05 07 08 10 11 13 15 17 19 21 23 25
+15 acertos
+3 apostas ganhadoras, R$ 612.345,67
+11 acertos
+250.000 apostas ganhadoras, R$ 6,00`,
+			want: []lottery.Winner{
+				{Tier: 1, Winners: 3, Prize: 612345.67},
+				{Tier: 5, Winners: 250000, Prize: 6},
+			},
+		},
+		{
+			name:  "dois sorteios da Dupla Sena",
+			ltype: lottery.DuplaSena,
+			text: `Concurso 2700
+1º sorteio
+01 02 03 04 05 06
+2º sorteio
+10 20 30 40 45 50
+6 acertos
+Não houve acertador
+6 acertos
+1 aposta ganhadora, R$ 800.000,00`,
+			want: []lottery.Winner{
+				{Tier: 1},
+				{Tier: 5, Winners: 1, Prize: 800000},
+			},
+		},
+		{
+			name:  "palpite extra e faixa pelo nome",
+			ltype: lottery.DiaDeSorte,
+			text: `Concurso 950
+01 05 10 15 20 25 30
+Mês da Sorte: Março
+7 acertos
+Não houve acertador
+Mês da Sorte
+150.000 apostas ganhadoras, R$ 2,50`,
+			want: []lottery.Winner{
+				{Tier: 1},
+				{Tier: 5, Winners: 150000, Prize: 2.5},
+			},
+			extraPick: "Março",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			draw, err := ParseManualDraw(tt.ltype, tt.text)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ParseManualDraw() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseManualDraw() error = %v", err)
+			}
+			if !reflect.DeepEqual(draw.Winners, tt.want) {
+				t.Errorf("Winners = %+v, want %+v", draw.Winners, tt.want)
+			}
+			if draw.ExtraPick != tt.extraPick {
+				t.Errorf("ExtraPick = %q, want %q", draw.ExtraPick, tt.extraPick)
+			}
+		})
+	}
+}
+
+func TestValidateManualDraw(t *testing.T) {
+	tests := []struct {
+		name    string
+		ltype   lottery.LotteryType
+		text    string
+		wantErr string
+	}{
+		{
+			name:  "Lotofácil com 15 dezenas",
+			ltype: lottery.Lotofacil,
+			text: `Concurso 3200 (02/09/2024)
+01 02 04 05 07 08 10 11 13 15 17 19 21 23 25`,
+		},
+		{
+			name:  "Lotofácil com dezena repetida",
+			ltype: lottery.Lotofacil,
+			text: `Concurso 3200 (02/09/2024)
+01 02 04 05 07 08 10 11 13 15 17 19 21 23 23`,
+			wantErr: "repetid",
+		},
+		{
+			name:  "Mega-Sena com 6 dezenas",
+			ltype: lottery.MegaSena,
+			text: `Concurso 2800 (10/10/2024)
+01 12 23 34 45 56`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			draw, err := ParseManualDraw(tt.ltype, tt.text)
+			if err != nil {
+				t.Fatalf("ParseManualDraw() error = %v", err)
+			}
+			if got, want := len(draw.Numbers), lottery.GetRules(tt.ltype).ResultNumbers; got != want {
+				t.Fatalf("ParseManualDraw() leu %d dezenas, want %d", got, want)
+			}
+
+			err = ValidateManualDraw(tt.ltype, draw)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidateManualDraw() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ValidateManualDraw() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,227 @@
+package data
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"lottery-optimizer-gui/internal/config"
+	"lottery-optimizer-gui/internal/logs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// Modos de acesso aos dados (app.data_mode ou --data-mode)
+const (
+	DataModeLive   = "live"   // Requisições reais à API
+	DataModeRecord = "record" // Requisições reais, gravando cada resposta no diretório de fixtures
+	DataModeReplay = "replay" // Sem rede: responde com as fixtures gravadas
+)
+
+// fixtureHeaders cabeçalhos de resposta guardados na fixture (o resto, como cookies, é descartado)
+var fixtureHeaders = []string{"Content-Type", "Content-Encoding"}
+
+// maxFixtureNameLength limita o nome do arquivo; nomes maiores ganham um hash no lugar do excesso
+const maxFixtureNameLength = 150
+
+// fixture é uma resposta HTTP gravada
+type fixture struct {
+	Method     string            `json:"method"`
+	URL        string            `json:"url"`
+	Status     int               `json:"status"`
+	Header     map[string]string `json:"header,omitempty"`
+	Body       string            `json:"body,omitempty"`        // Corpo em texto (JSON da CAIXA)
+	BodyBase64 []byte            `json:"body_base64,omitempty"` // Corpo binário ou comprimido
+	RecordedAt time.Time         `json:"recorded_at"`
+}
+
+var unsafeFixtureChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// fixtureName gera o nome do arquivo da fixture a partir do método e da URL
+// Ex: GET servicebus2.caixa.gov.br/portaldeloterias/api/megasena/2800 -> GET_servicebus2.caixa.gov.br_portaldeloterias_api_megasena_2800.json
+// A porta fica de fora, para gravações de um servidor local continuarem valendo se ele mudar de porta
+func fixtureName(req *http.Request) string {
+	key := req.Method + " " + req.URL.Hostname() + req.URL.Path
+	if req.URL.RawQuery != "" {
+		key += "?" + req.URL.RawQuery
+	}
+
+	name := strings.Trim(unsafeFixtureChars.ReplaceAllString(key, "_"), "_")
+	if len(name) > maxFixtureNameLength {
+		sum := sha1.Sum([]byte(key))
+		name = name[:maxFixtureNameLength] + "_" + hex.EncodeToString(sum[:])[:12]
+	}
+	return name + ".json"
+}
+
+// recordingTransport faz as requisições normalmente e grava cada resposta como fixture
+type recordingTransport struct {
+	dir  string
+	next http.RoundTripper
+}
+
+func (t *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		// Erros de rede não têm resposta para gravar
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	rec := fixture{
+		Method:     req.Method,
+		URL:        req.URL.String(),
+		Status:     resp.StatusCode,
+		Header:     make(map[string]string),
+		RecordedAt: time.Now(),
+	}
+	for _, name := range fixtureHeaders {
+		if value := resp.Header.Get(name); value != "" {
+			rec.Header[name] = value
+		}
+	}
+	if rec.Header["Content-Encoding"] == "" && utf8.Valid(body) {
+		rec.Body = string(body)
+	} else {
+		rec.BodyBase64 = body
+	}
+
+	if err := writeFixture(filepath.Join(t.dir, fixtureName(req)), rec); err != nil {
+		logs.LogError(logs.CategoryData, "Erro ao gravar fixture de %s: %v", req.URL, err)
+	} else if config.IsVerbose() {
+		logs.LogData("📼 Gravado %s %s (%d)", req.Method, req.URL, resp.StatusCode)
+	}
+
+	return resp, nil
+}
+
+// writeFixture grava a fixture por um arquivo temporário, para buscas paralelas não se misturarem
+func writeFixture(path string, rec fixture) error {
+	data, err := json.MarshalIndent(rec, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".fixture-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// replayTransport responde às requisições com as fixtures gravadas, sem acessar a rede
+// Uma requisição sem fixture recebe 404, como um concurso que a fonte não tem
+type replayTransport struct {
+	dir string
+}
+
+func (t *replayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+
+	name := fixtureName(req)
+	data, err := os.ReadFile(filepath.Join(t.dir, name))
+	if os.IsNotExist(err) {
+		logs.LogData("📼 Sem fixture para %s %s (%s)", req.Method, req.URL, name)
+		return replayResponse(req, http.StatusNotFound, nil, nil), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("erro ao ler fixture %s: %w", name, err)
+	}
+
+	var rec fixture
+	if err := json.Unmarshal(data, &rec); err != nil {
+		return nil, fmt.Errorf("fixture %s inválida: %w", name, err)
+	}
+
+	body := rec.BodyBase64
+	if body == nil {
+		body = []byte(rec.Body)
+	}
+	return replayResponse(req, rec.Status, rec.Header, body), nil
+}
+
+// replayResponse monta a resposta HTTP servida no modo replay
+func replayResponse(req *http.Request, status int, header map[string]string, body []byte) *http.Response {
+	resp := &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        make(http.Header),
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+	for name, value := range header {
+		resp.Header.Set(name, value)
+	}
+	return resp
+}
+
+// fixturesDir retorna o diretório de fixtures configurado (padrão: ~/.lottery-optimizer/fixtures)
+func fixturesDir(cfg config.AppConfig) string {
+	if cfg.FixturesDir != "" {
+		return cfg.FixturesDir
+	}
+	homeDir, _ := os.UserHomeDir()
+	return filepath.Join(homeDir, ".lottery-optimizer", "fixtures")
+}
+
+// configureDataMode instala no cliente HTTP o transporte do modo de dados configurado
+// Retorna o modo efetivo; um modo desconhecido (ou sem diretório de fixtures utilizável) cai para live
+func configureDataMode(client *resty.Client, cfg config.AppConfig) string {
+	mode := strings.ToLower(strings.TrimSpace(cfg.DataMode))
+	if mode == "" || mode == DataModeLive {
+		return DataModeLive
+	}
+
+	dir := fixturesDir(cfg)
+	switch mode {
+	case DataModeRecord:
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			logs.LogError(logs.CategoryData, "Erro ao criar diretório de fixtures %s: %v", dir, err)
+			return DataModeLive
+		}
+		client.SetTransport(&recordingTransport{dir: dir, next: http.DefaultTransport})
+		logs.LogData("📼 Modo record: gravando respostas em %s", dir)
+
+	case DataModeReplay:
+		client.SetTransport(&replayTransport{dir: dir})
+		// Respostas gravadas não mudam: repetir a requisição só atrasaria
+		client.SetRetryCount(0)
+		logs.LogData("📼 Modo replay: respondendo com as fixtures de %s", dir)
+
+	default:
+		logs.LogError(logs.CategoryData, "Modo de dados desconhecido %q, usando live", cfg.DataMode)
+		return DataModeLive
+	}
+
+	return mode
+}
@@ -0,0 +1,138 @@
+package data
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"lottery-optimizer-gui/internal/config"
+	"lottery-optimizer-gui/internal/lottery"
+	"lottery-optimizer-gui/internal/mockcaixa"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// modeSource cria uma fonte da CAIXA com o transporte do modo de dados informado
+func modeSource(t *testing.T, mode, dir, baseURL string) *caixaSource {
+	t.Helper()
+	client := resty.New()
+	if got := configureDataMode(client, config.AppConfig{DataMode: mode, FixturesDir: dir}); got != mode {
+		t.Fatalf("configureDataMode(%s) = %s", mode, got)
+	}
+	return &caixaSource{name: SourceCaixa, client: client, baseURL: baseURL}
+}
+
+func TestRecordThenReplay(t *testing.T) {
+	generator := &mockcaixa.Generator{
+		Seed:     42,
+		Schedule: mockcaixa.Schedule{Start: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	server := httptest.NewServer(mockcaixa.NewServer(generator, mockcaixa.Faults{}))
+	baseURL := server.URL + "/portaldeloterias/api"
+	dir := t.TempDir()
+
+	recorder := modeSource(t, DataModeRecord, dir, baseURL)
+	latest, err := recorder.Latest(lottery.MegaSena)
+	if err != nil {
+		t.Fatalf("Latest() gravando: %v", err)
+	}
+	recorded, err := recorder.Contest(lottery.MegaSena, 10)
+	if err != nil {
+		t.Fatalf("Contest(10) gravando: %v", err)
+	}
+	if _, err := recorder.Contest(lottery.MegaSena, latest.Number+100); !errors.Is(err, ErrDrawNotFound) {
+		t.Fatalf("Contest() futuro gravando: error = %v, want ErrDrawNotFound", err)
+	}
+	server.Close()
+
+	// O replay não acessa a rede: o servidor já foi desligado
+	replayer := modeSource(t, DataModeReplay, dir, baseURL)
+	replayedLatest, err := replayer.Latest(lottery.MegaSena)
+	if err != nil {
+		t.Fatalf("Latest() no replay: %v", err)
+	}
+	if !reflect.DeepEqual(replayedLatest, latest) {
+		t.Errorf("Latest() no replay = %+v, want %+v", replayedLatest, latest)
+	}
+	replayed, err := replayer.Contest(lottery.MegaSena, 10)
+	if err != nil {
+		t.Fatalf("Contest(10) no replay: %v", err)
+	}
+	if !reflect.DeepEqual(replayed, recorded) {
+		t.Errorf("Contest(10) no replay = %+v, want %+v", replayed, recorded)
+	}
+
+	// O 404 gravado é reproduzido, e um concurso nunca pedido também volta como não encontrado
+	for _, number := range []int{latest.Number + 100, 11} {
+		if _, err := replayer.Contest(lottery.MegaSena, number); !errors.Is(err, ErrDrawNotFound) {
+			t.Errorf("Contest(%d) no replay: error = %v, want ErrDrawNotFound", number, err)
+		}
+	}
+
+	// Servidores locais mudam de porta entre execuções; a fixture continua valendo
+	moved := modeSource(t, DataModeReplay, dir, strings.Replace(baseURL, server.Listener.Addr().String(), "127.0.0.1:1", 1))
+	if _, err := moved.Contest(lottery.MegaSena, 10); err != nil {
+		t.Errorf("Contest(10) em outra porta: %v", err)
+	}
+}
+
+func TestFixtureName(t *testing.T) {
+	request := func(url string) *http.Request {
+		req, err := http.NewRequest(http.MethodGet, url, nil)
+		if err != nil {
+			t.Fatalf("NewRequest: %v", err)
+		}
+		return req
+	}
+
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{"https://servicebus2.caixa.gov.br/portaldeloterias/api/megasena/2800", "GET_servicebus2.caixa.gov.br_portaldeloterias_api_megasena_2800.json"},
+		{"http://127.0.0.1:8080/portaldeloterias/api/megasena/", "GET_127.0.0.1_portaldeloterias_api_megasena.json"},
+		{"https://espelho.example/draws?lottery=quina&n=5", "GET_espelho.example_draws_lottery_quina_n_5.json"},
+	}
+	for _, tt := range tests {
+		if got := fixtureName(request(tt.url)); got != tt.want {
+			t.Errorf("fixtureName(%s) = %s, want %s", tt.url, got, tt.want)
+		}
+	}
+
+	// Nomes longos são cortados com um hash, sem colidir entre URLs diferentes
+	long := "https://espelho.example/" + strings.Repeat("a", 200)
+	first, second := fixtureName(request(long+"/1")), fixtureName(request(long+"/2"))
+	if len(first) > maxFixtureNameLength+20 || first == second {
+		t.Errorf("fixtureName() de URLs longas = %s e %s", first, second)
+	}
+}
+
+func TestConfigureDataModeFallsBackToLive(t *testing.T) {
+	// Um arquivo no lugar do diretório impede a gravação
+	blocked := filepath.Join(t.TempDir(), "fixtures")
+	if err := os.WriteFile(blocked, nil, 0644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	tests := []struct {
+		mode string
+		dir  string
+		want string
+	}{
+		{"", "", DataModeLive},
+		{" Replay ", t.TempDir(), DataModeReplay},
+		{"gravar", t.TempDir(), DataModeLive},
+		{DataModeRecord, blocked, DataModeLive},
+	}
+	for _, tt := range tests {
+		if got := configureDataMode(resty.New(), config.AppConfig{DataMode: tt.mode, FixturesDir: tt.dir}); got != tt.want {
+			t.Errorf("configureDataMode(%q) = %s, want %s", tt.mode, got, tt.want)
+		}
+	}
+}
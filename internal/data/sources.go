@@ -0,0 +1,418 @@
+package data
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"lottery-optimizer-gui/internal/config"
+	"lottery-optimizer-gui/internal/logs"
+	"lottery-optimizer-gui/internal/lottery"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// ErrDrawNotFound indica que a fonte não tem o concurso pedido (não conta como falha da fonte)
+var ErrDrawNotFound = errors.New("sorteio não encontrado na fonte")
+
+// ErrSourcesPaused indica que todas as fontes estão em pausa após falhas seguidas
+var ErrSourcesPaused = errors.New("todas as fontes em pausa")
+
+// Saúde das fontes: após algumas falhas seguidas a fonte é pulada por um tempo
+const (
+	sourceFailureThreshold = 3
+	sourceCooldown         = 5 * time.Minute
+)
+
+// Tipos de fonte aceitos em app.data_sources
+const (
+	SourceCaixa  = "caixa"
+	SourceMirror = "mirror"
+	SourceFile   = "file"
+)
+
+// DrawSource é uma origem de sorteios (API da CAIXA, espelho JSON, arquivos locais)
+// Os sorteios retornados já vêm normalizados para a loteria pedida
+type DrawSource interface {
+	Name() string
+	Latest(ltype lottery.LotteryType) (*lottery.Draw, error)
+	Contest(ltype lottery.LotteryType, number int) (*lottery.Draw, error)
+}
+
+// SourceStatus descreve a saúde de uma fonte de sorteios
+type SourceStatus struct {
+	Name         string    `json:"name"`
+	Priority     int       `json:"priority"` // Posição na cadeia (1 = primeira tentativa)
+	Healthy      bool      `json:"healthy"`
+	Failures     int       `json:"failures"` // Falhas seguidas
+	LastError    string    `json:"last_error,omitempty"`
+	LastSuccess  time.Time `json:"last_success,omitempty"`
+	SkippedUntil time.Time `json:"skipped_until,omitempty"`
+}
+
+// caixaSource busca sorteios na API oficial da CAIXA
+type caixaSource struct {
+	name    string
+	client  *resty.Client
+	baseURL string
+}
+
+func (s *caixaSource) Name() string { return s.name }
+
+func (s *caixaSource) Latest(ltype lottery.LotteryType) (*lottery.Draw, error) {
+	return getJSONDraw(s.client, fmt.Sprintf("%s/%s/", s.baseURL, lottery.APISlug(ltype)), ltype)
+}
+
+func (s *caixaSource) Contest(ltype lottery.LotteryType, number int) (*lottery.Draw, error) {
+	return getJSONDraw(s.client, fmt.Sprintf("%s/%s/%d", s.baseURL, lottery.APISlug(ltype), number), ltype)
+}
+
+// mirrorSource busca sorteios em um espelho que publica o mesmo JSON da CAIXA
+// A URL aceita os marcadores {slug} (nome na API da CAIXA), {lottery} (tipo canônico) e {number}
+// Sem latest_url, o último sorteio é buscado na própria url com {number} vazio
+type mirrorSource struct {
+	name      string
+	client    *resty.Client
+	url       string
+	latestURL string
+}
+
+func (s *mirrorSource) Name() string { return s.name }
+
+func (s *mirrorSource) Latest(ltype lottery.LotteryType) (*lottery.Draw, error) {
+	url := s.latestURL
+	if url == "" {
+		url = s.url
+	}
+	return getJSONDraw(s.client, expandSourceURL(url, ltype, ""), ltype)
+}
+
+func (s *mirrorSource) Contest(ltype lottery.LotteryType, number int) (*lottery.Draw, error) {
+	return getJSONDraw(s.client, expandSourceURL(s.url, ltype, strconv.Itoa(number)), ltype)
+}
+
+// expandSourceURL substitui os marcadores da URL do espelho
+func expandSourceURL(url string, ltype lottery.LotteryType, number string) string {
+	return strings.NewReplacer(
+		"{slug}", lottery.APISlug(ltype),
+		"{lottery}", string(ltype),
+		"{number}", number,
+	).Replace(url)
+}
+
+// getJSONDraw busca e decodifica um sorteio no formato da API da CAIXA
+func getJSONDraw(client *resty.Client, url string, ltype lottery.LotteryType) (*lottery.Draw, error) {
+	resp, err := client.R().SetHeader("Accept", "application/json").Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("erro de conectividade: %w", err)
+	}
+
+	switch resp.StatusCode() {
+	case 200:
+	case 403:
+		return nil, ErrBlocked
+	case 404:
+		return nil, ErrDrawNotFound
+	case 429:
+		return nil, ErrRateLimited
+	default:
+		return nil, fmt.Errorf("API retornou status %d", resp.StatusCode())
+	}
+
+	var draw lottery.Draw
+	if err := json.Unmarshal(resp.Body(), &draw); err != nil {
+		return nil, fmt.Errorf("erro ao decodificar resposta da API: %w", err)
+	}
+	if draw.Number == 0 {
+		return nil, ErrDrawNotFound
+	}
+	draw.Normalize(ltype)
+
+	return &draw, nil
+}
+
+// fileSource lê sorteios de arquivos locais {dir}/{loteria}.json
+// Cada arquivo pode ser uma lista de sorteios no formato da CAIXA ou um arquivo do cache do app
+type fileSource struct {
+	name string
+	dir  string
+
+	mu      sync.Mutex
+	loaded  map[lottery.LotteryType]map[int]lottery.Draw
+	modTime map[lottery.LotteryType]time.Time
+}
+
+func (s *fileSource) Name() string { return s.name }
+
+func (s *fileSource) Latest(ltype lottery.LotteryType) (*lottery.Draw, error) {
+	draws, err := s.load(ltype)
+	if err != nil {
+		return nil, err
+	}
+
+	var latest *lottery.Draw
+	for number := range draws {
+		if latest == nil || number > latest.Number {
+			draw := draws[number]
+			latest = &draw
+		}
+	}
+	if latest == nil {
+		return nil, ErrDrawNotFound
+	}
+	return latest, nil
+}
+
+func (s *fileSource) Contest(ltype lottery.LotteryType, number int) (*lottery.Draw, error) {
+	draws, err := s.load(ltype)
+	if err != nil {
+		return nil, err
+	}
+
+	draw, ok := draws[number]
+	if !ok {
+		return nil, ErrDrawNotFound
+	}
+	return &draw, nil
+}
+
+// load lê o arquivo da loteria, relendo só quando ele muda
+func (s *fileSource) load(ltype lottery.LotteryType) (map[int]lottery.Draw, error) {
+	path := filepath.Join(s.dir, string(ltype)+".json")
+
+	info, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return nil, ErrDrawNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("erro ao acessar %s: %w", path, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if draws, ok := s.loaded[ltype]; ok && s.modTime[ltype].Equal(info.ModTime()) {
+		return draws, nil
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao ler %s: %w", path, err)
+	}
+
+	var list []lottery.Draw
+	if err := json.Unmarshal(content, &list); err != nil {
+		var entry CacheEntry
+		if cacheErr := json.Unmarshal(content, &entry); cacheErr != nil {
+			return nil, fmt.Errorf("formato inválido em %s: %w", path, err)
+		}
+		list = entry.Draws
+	}
+
+	draws := make(map[int]lottery.Draw, len(list))
+	for _, draw := range list {
+		draw.Normalize(ltype)
+		draws[draw.Number] = draw
+	}
+
+	s.loaded[ltype] = draws
+	s.modTime[ltype] = info.ModTime()
+	return draws, nil
+}
+
+// newDrawSources monta as fontes configuradas em app.data_sources, na ordem de prioridade
+// Sem configuração, usa só a API da CAIXA em app.data_source_url
+func newDrawSources(client *resty.Client, cfg config.AppConfig) []DrawSource {
+	var sources []DrawSource
+	used := make(map[string]bool)
+
+	for i, sc := range cfg.DataSources {
+		name := sc.Name
+		if name == "" {
+			name = strings.ToLower(sc.Type)
+		}
+		if used[name] {
+			name = fmt.Sprintf("%s-%d", name, i+1)
+		}
+		used[name] = true
+
+		switch strings.ToLower(sc.Type) {
+		case SourceCaixa:
+			url := sc.URL
+			if url == "" {
+				url = cfg.DataSourceURL
+			}
+			sources = append(sources, &caixaSource{name: name, client: client, baseURL: url})
+		case SourceMirror:
+			if sc.URL == "" {
+				logs.LogError(logs.CategoryData, "Fonte %s ignorada: url não configurada", name)
+				continue
+			}
+			sources = append(sources, &mirrorSource{name: name, client: client, url: sc.URL, latestURL: sc.LatestURL})
+		case SourceFile:
+			if sc.Path == "" {
+				logs.LogError(logs.CategoryData, "Fonte %s ignorada: path não configurado", name)
+				continue
+			}
+			sources = append(sources, &fileSource{
+				name:    name,
+				dir:     sc.Path,
+				loaded:  make(map[lottery.LotteryType]map[int]lottery.Draw),
+				modTime: make(map[lottery.LotteryType]time.Time),
+			})
+		default:
+			logs.LogError(logs.CategoryData, "Fonte de sorteios desconhecida: %q", sc.Type)
+		}
+	}
+
+	if len(sources) == 0 {
+		sources = append(sources, &caixaSource{name: SourceCaixa, client: client, baseURL: cfg.DataSourceURL})
+	}
+	return sources
+}
+
+// sourceHealth acompanha as falhas seguidas de uma fonte
+type sourceHealth struct {
+	failures     int
+	lastError    string
+	lastSuccess  time.Time
+	skippedUntil time.Time
+}
+
+// sourceChain tenta as fontes em ordem de prioridade, pulando as que estão falhando
+type sourceChain struct {
+	sources []DrawSource
+
+	mu     sync.Mutex
+	health map[string]*sourceHealth
+}
+
+// newSourceChain cria a cadeia de failover com as fontes em ordem de prioridade
+func newSourceChain(sources []DrawSource) *sourceChain {
+	health := make(map[string]*sourceHealth, len(sources))
+	for _, source := range sources {
+		health[source.Name()] = &sourceHealth{}
+	}
+	return &sourceChain{sources: sources, health: health}
+}
+
+// Latest busca o último sorteio na primeira fonte disponível que responder
+func (sc *sourceChain) Latest(ltype lottery.LotteryType) (*lottery.Draw, error) {
+	return sc.try(func(source DrawSource) (*lottery.Draw, error) {
+		return source.Latest(ltype)
+	})
+}
+
+// Contest busca um concurso na primeira fonte disponível que o tiver
+func (sc *sourceChain) Contest(ltype lottery.LotteryType, number int) (*lottery.Draw, error) {
+	return sc.try(func(source DrawSource) (*lottery.Draw, error) {
+		return source.Contest(ltype, number)
+	})
+}
+
+// try percorre as fontes disponíveis; se todas estiverem em pausa, falha sem consultar nenhuma
+// Quando nenhuma responde, um bloqueio (403/429) tem precedência para o chamador reduzir o ritmo
+func (sc *sourceChain) try(fetch func(DrawSource) (*lottery.Draw, error)) (*lottery.Draw, error) {
+	candidates, retryAt := sc.available()
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("%w, próxima tentativa às %s", ErrSourcesPaused, retryAt.Format("15:04:05"))
+	}
+
+	var firstErr, throttledErr error
+	for _, source := range candidates {
+		draw, err := fetch(source)
+		if err == nil {
+			sc.succeeded(source.Name())
+			return draw, nil
+		}
+
+		if !errors.Is(err, ErrDrawNotFound) {
+			sc.failed(source.Name(), err)
+		}
+		if isThrottled(err) && throttledErr == nil {
+			throttledErr = fmt.Errorf("%s: %w", source.Name(), err)
+		}
+		if firstErr == nil {
+			firstErr = fmt.Errorf("%s: %w", source.Name(), err)
+		}
+	}
+
+	if throttledErr != nil {
+		return nil, throttledErr
+	}
+	return nil, firstErr
+}
+
+// available retorna as fontes que não estão em pausa e, se todas estiverem, quando a primeira volta
+func (sc *sourceChain) available() ([]DrawSource, time.Time) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	now := time.Now()
+	var sources []DrawSource
+	var retryAt time.Time
+	for _, source := range sc.sources {
+		skippedUntil := sc.health[source.Name()].skippedUntil
+		if now.After(skippedUntil) {
+			sources = append(sources, source)
+			continue
+		}
+		if retryAt.IsZero() || skippedUntil.Before(retryAt) {
+			retryAt = skippedUntil
+		}
+	}
+	return sources, retryAt
+}
+
+func (sc *sourceChain) succeeded(name string) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	health := sc.health[name]
+	health.failures = 0
+	health.lastSuccess = time.Now()
+	health.skippedUntil = time.Time{}
+}
+
+func (sc *sourceChain) failed(name string, err error) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	health := sc.health[name]
+	health.failures++
+	health.lastError = err.Error()
+	if health.failures >= sourceFailureThreshold && time.Now().After(health.skippedUntil) {
+		health.skippedUntil = time.Now().Add(sourceCooldown)
+		logs.LogData("🔌 Fonte %s falhou %d vezes seguidas, pulando até %s", name, health.failures, health.skippedUntil.Format("15:04"))
+	}
+}
+
+// Status retorna a saúde de cada fonte na ordem de prioridade
+func (sc *sourceChain) Status() []SourceStatus {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	now := time.Now()
+	statuses := make([]SourceStatus, 0, len(sc.sources))
+	for i, source := range sc.sources {
+		health := sc.health[source.Name()]
+		status := SourceStatus{
+			Name:        source.Name(),
+			Priority:    i + 1,
+			Healthy:     !now.Before(health.skippedUntil),
+			Failures:    health.failures,
+			LastError:   health.lastError,
+			LastSuccess: health.lastSuccess,
+		}
+		if !status.Healthy {
+			status.SkippedUntil = health.skippedUntil
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
@@ -0,0 +1,94 @@
+package data
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"lottery-optimizer-gui/internal/lottery"
+)
+
+// countingSource conta quantas vezes a cadeia consultou a fonte
+type countingSource struct {
+	DrawSource
+	calls int
+}
+
+func (s *countingSource) Contest(ltype lottery.LotteryType, number int) (*lottery.Draw, error) {
+	s.calls++
+	return s.DrawSource.Contest(ltype, number)
+}
+
+func TestSourceChainHealth(t *testing.T) {
+	primary := &countingSource{DrawSource: &stubSource{name: "primary", err: errors.New("API retornou status 500")}}
+	backup := &countingSource{DrawSource: &stubSource{name: "backup", draws: map[int]lottery.Draw{1: storedDrawWithPrizes(1)}}}
+	chain := newSourceChain([]DrawSource{primary, backup})
+
+	// A fonte principal falha e a reserva responde; depois do limite de falhas a principal é pulada
+	for i := 0; i < sourceFailureThreshold+1; i++ {
+		draw, err := chain.Contest(lottery.Quina, 1)
+		if err != nil || draw.Number != 1 {
+			t.Fatalf("tentativa %d: Contest() = %v, %v", i+1, draw, err)
+		}
+	}
+	if primary.calls != sourceFailureThreshold {
+		t.Errorf("principal consultada %d vezes, want %d", primary.calls, sourceFailureThreshold)
+	}
+
+	status := chain.Status()
+	if status[0].Healthy || status[0].Failures != sourceFailureThreshold || status[0].SkippedUntil.IsZero() {
+		t.Errorf("Status() principal = %+v", status[0])
+	}
+	if !status[1].Healthy || status[1].Failures != 0 || status[1].LastSuccess.IsZero() {
+		t.Errorf("Status() reserva = %+v", status[1])
+	}
+
+	// Concurso que a reserva não tem não conta como falha dela
+	if _, err := chain.Contest(lottery.Quina, 2); !errors.Is(err, ErrDrawNotFound) {
+		t.Errorf("Contest(2) error = %v, want ErrDrawNotFound", err)
+	}
+	if failures := chain.Status()[1].Failures; failures != 0 {
+		t.Errorf("reserva com %d falhas após concurso inexistente", failures)
+	}
+
+	// Passada a pausa, a principal volta a ser consultada primeiro
+	chain.health["primary"].skippedUntil = time.Now().Add(-time.Second)
+	primary.DrawSource.(*stubSource).err = nil
+	primary.DrawSource.(*stubSource).draws = map[int]lottery.Draw{1: storedDrawWithPrizes(1)}
+	calls := backup.calls
+	if _, err := chain.Contest(lottery.Quina, 1); err != nil {
+		t.Fatalf("Contest() após a pausa: %v", err)
+	}
+	if backup.calls != calls || !chain.Status()[0].Healthy || chain.Status()[0].Failures != 0 {
+		t.Errorf("principal não voltou: reserva consultada %d vezes, Status() = %+v", backup.calls-calls, chain.Status()[0])
+	}
+}
+
+func TestSourceChainAllPaused(t *testing.T) {
+	first := &countingSource{DrawSource: &stubSource{name: "first", err: errors.New("timeout")}}
+	second := &countingSource{DrawSource: &stubSource{name: "second", err: ErrRateLimited}}
+	chain := newSourceChain([]DrawSource{first, second})
+
+	for i := 0; i < sourceFailureThreshold; i++ {
+		// Sem resposta, o bloqueio tem precedência para o chamador reduzir o ritmo
+		if _, err := chain.Contest(lottery.Quina, 1); !isThrottled(err) {
+			t.Fatalf("tentativa %d: error = %v, want bloqueio", i+1, err)
+		}
+	}
+
+	retryAt := time.Now().Add(time.Minute)
+	chain.health["second"].skippedUntil = retryAt
+
+	_, err := chain.Contest(lottery.Quina, 1)
+	if !errors.Is(err, ErrSourcesPaused) {
+		t.Fatalf("error = %v, want ErrSourcesPaused", err)
+	}
+	// A mensagem traz a volta mais próxima entre as fontes em pausa
+	if !strings.Contains(err.Error(), retryAt.Format("15:04:05")) {
+		t.Errorf("error = %q, want próxima tentativa às %s", err, retryAt.Format("15:04:05"))
+	}
+	if first.calls != sourceFailureThreshold || second.calls != sourceFailureThreshold {
+		t.Errorf("fontes em pausa consultadas: %d e %d vezes, want %d", first.calls, second.calls, sourceFailureThreshold)
+	}
+}
@@ -0,0 +1,497 @@
+package data
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"lottery-optimizer-gui/internal/logs"
+	"lottery-optimizer-gui/internal/lottery"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	_ "modernc.org/sqlite"
+)
+
+// DrawStore guarda em SQLite todos os concursos já buscados, com suas faixas de prêmio
+// Diferente do cache JSON, nada expira: um concurso apurado não muda mais
+type DrawStore struct {
+	db *sql.DB
+}
+
+var (
+	sharedStore     *DrawStore
+	sharedStoreOnce sync.Once
+)
+
+// defaultDrawStore abre (uma única vez) o banco de sorteios no diretório do app
+// Retorna nil se o banco não puder ser aberto; o cliente segue só com a API e o cache
+func defaultDrawStore() *DrawStore {
+	sharedStoreOnce.Do(func() {
+		homeDir, _ := os.UserHomeDir()
+		dataDir := filepath.Join(homeDir, ".lottery-optimizer")
+		os.MkdirAll(dataDir, 0755)
+
+		store, err := NewDrawStore(filepath.Join(dataDir, "draws.db"))
+		if err != nil {
+			logs.LogError(logs.CategoryData, "Erro ao abrir banco de sorteios: %v", err)
+			return
+		}
+		sharedStore = store
+	})
+	return sharedStore
+}
+
+// NewDrawStore abre o banco de sorteios e cria as tabelas se necessário
+func NewDrawStore(dbPath string) (*DrawStore, error) {
+	db, err := sql.Open("sqlite", dbPath)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao abrir banco de sorteios: %w", err)
+	}
+
+	store := &DrawStore{db: db}
+	if err := store.createTables(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return store, nil
+}
+
+// createTables cria as tabelas de sorteios e faixas de prêmio
+func (s *DrawStore) createTables() error {
+	query := `
+	CREATE TABLE IF NOT EXISTS draws (
+		lottery_type TEXT NOT NULL,
+		number INTEGER NOT NULL,
+		draw_date TEXT NOT NULL,   -- YYYY-MM-DD
+		numbers TEXT NOT NULL,     -- JSON array das dezenas sorteadas
+		accumulated INTEGER NOT NULL DEFAULT 0,
+		draw_json TEXT NOT NULL,   -- Sorteio completo (lottery.Draw normalizado)
+		fetched_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		PRIMARY KEY (lottery_type, number)
+	);
+
+	CREATE TABLE IF NOT EXISTS draw_prize_tiers (
+		lottery_type TEXT NOT NULL,
+		number INTEGER NOT NULL,
+		faixa INTEGER NOT NULL,
+		description TEXT NOT NULL DEFAULT '',
+		winners INTEGER NOT NULL DEFAULT 0,
+		prize REAL NOT NULL DEFAULT 0,
+		PRIMARY KEY (lottery_type, number, faixa)
+	);
+	`
+
+	if _, err := s.db.Exec(query); err != nil {
+		return fmt.Errorf("erro ao criar tabelas de sorteios: %w", err)
+	}
+
+	// Valores do payload completo da CAIXA, em colunas para consultas das análises
+	columns := []struct{ name, definition string }{
+		{"accumulated_value", "REAL NOT NULL DEFAULT 0"},
+		{"estimated_next_prize", "REAL NOT NULL DEFAULT 0"},
+		{"special_draw_value", "REAL NOT NULL DEFAULT 0"},
+		{"location", "TEXT NOT NULL DEFAULT ''"},
+		{"manual", "INTEGER NOT NULL DEFAULT 0"}, // Resultado informado pelo usuário (ver SaveManualDraw)
+	}
+	for _, column := range columns {
+		if err := s.addColumnIfNotExists("draws", column.name, column.definition); err != nil {
+			return err
+		}
+	}
+
+	_, err := s.db.Exec(`
+	CREATE TABLE IF NOT EXISTS draw_winner_locations (
+		lottery_type TEXT NOT NULL,
+		number INTEGER NOT NULL,
+		position INTEGER NOT NULL,
+		winners INTEGER NOT NULL DEFAULT 0,
+		city TEXT NOT NULL DEFAULT '',
+		state TEXT NOT NULL DEFAULT '',
+		outlet TEXT NOT NULL DEFAULT '',
+		series TEXT NOT NULL DEFAULT '',
+		PRIMARY KEY (lottery_type, number, position)
+	);
+	`)
+	if err != nil {
+		return fmt.Errorf("erro ao criar tabela de cidades dos ganhadores: %w", err)
+	}
+
+	// Resultados manuais substituídos pelo oficial e as diferenças encontradas
+	_, err = s.db.Exec(`
+	CREATE TABLE IF NOT EXISTS draw_corrections (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		lottery_type TEXT NOT NULL,
+		number INTEGER NOT NULL,
+		discrepancies TEXT NOT NULL DEFAULT '[]', -- JSON array de DrawDiscrepancy
+		replaced_at DATETIME NOT NULL,
+		applied INTEGER NOT NULL DEFAULT 0        -- Jogos conferidos com o resultado manual já foram reabertos
+	);
+	`)
+	if err != nil {
+		return fmt.Errorf("erro ao criar tabela de correções de sorteios: %w", err)
+	}
+	return nil
+}
+
+// addColumnIfNotExists adiciona uma coluna à tabela se ela ainda não existir
+func (s *DrawStore) addColumnIfNotExists(table, columnName, columnDefinition string) error {
+	rows, err := s.db.Query(fmt.Sprintf("PRAGMA table_info(%s)", table))
+	if err != nil {
+		return fmt.Errorf("erro ao verificar estrutura da tabela %s: %w", table, err)
+	}
+
+	columnExists := false
+	for rows.Next() {
+		var cid int
+		var name, dataType string
+		var notNull, dfltValue, pk interface{}
+
+		if err := rows.Scan(&cid, &name, &dataType, &notNull, &dfltValue, &pk); err != nil {
+			rows.Close()
+			return fmt.Errorf("erro ao ler informações da coluna: %w", err)
+		}
+		if name == columnName {
+			columnExists = true
+		}
+	}
+	rows.Close()
+
+	if columnExists {
+		return nil
+	}
+	if _, err := s.db.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", table, columnName, columnDefinition)); err != nil {
+		return fmt.Errorf("erro ao adicionar coluna %s: %w", columnName, err)
+	}
+	return nil
+}
+
+// SaveDraws grava (ou atualiza) os sorteios e suas faixas de prêmio e retorna quantos foram gravados
+// Sorteios ainda não apurados e resultados manuais de concursos que já têm o oficial são pulados
+func (s *DrawStore) SaveDraws(ltype lottery.LotteryType, draws []lottery.Draw) (int, error) {
+	if len(draws) == 0 {
+		return 0, nil
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("erro ao iniciar transação: %w", err)
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+	saved := 0
+	for _, draw := range draws {
+		if !isComplete(ltype, draw) {
+			continue
+		}
+
+		// Resultado manual nunca sobrescreve o oficial; o oficial substitui o manual registrando as diferenças
+		existing, err := storedDraw(tx, ltype, draw.Number)
+		if err != nil {
+			return 0, err
+		}
+		if existing != nil && !existing.Manual && draw.Manual {
+			continue
+		}
+		if existing != nil && existing.Manual && !draw.Manual {
+			if err := recordCorrection(tx, ltype, *existing, draw, now); err != nil {
+				return 0, err
+			}
+		}
+
+		drawJSON, err := json.Marshal(draw)
+		if err != nil {
+			return 0, fmt.Errorf("erro ao serializar sorteio %d: %w", draw.Number, err)
+		}
+		numbersJSON, err := json.Marshal([]int(draw.Numbers))
+		if err != nil {
+			return 0, fmt.Errorf("erro ao serializar dezenas do sorteio %d: %w", draw.Number, err)
+		}
+
+		accumulated := 0
+		if draw.Accumulated {
+			accumulated = 1
+		}
+
+		manual := 0
+		if draw.Manual {
+			manual = 1
+		}
+
+		location := strings.Trim(strings.Join([]string{draw.Location, draw.LocationCity}, " - "), " -")
+
+		_, err = tx.Exec(`
+			INSERT OR REPLACE INTO draws (lottery_type, number, draw_date, numbers, accumulated, draw_json, fetched_at,
+				accumulated_value, estimated_next_prize, special_draw_value, location, manual)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		`, string(ltype), draw.Number, draw.Date.Time().Format("2006-01-02"), string(numbersJSON), accumulated, string(drawJSON), now,
+			draw.AccumulatedValue, draw.EstimatedNextPrize, draw.SpecialDrawValue, location, manual)
+		if err != nil {
+			return 0, fmt.Errorf("erro ao gravar sorteio %d: %w", draw.Number, err)
+		}
+
+		if _, err := tx.Exec("DELETE FROM draw_prize_tiers WHERE lottery_type = ? AND number = ?", string(ltype), draw.Number); err != nil {
+			return 0, fmt.Errorf("erro ao limpar faixas do sorteio %d: %w", draw.Number, err)
+		}
+		for i, winner := range draw.Winners {
+			faixa := winner.Tier
+			if faixa == 0 {
+				faixa = i + 1
+			}
+			_, err := tx.Exec(`
+				INSERT OR REPLACE INTO draw_prize_tiers (lottery_type, number, faixa, description, winners, prize)
+				VALUES (?, ?, ?, ?, ?, ?)
+			`, string(ltype), draw.Number, faixa, winner.Description, winner.Winners, winner.Prize)
+			if err != nil {
+				return 0, fmt.Errorf("erro ao gravar faixas do sorteio %d: %w", draw.Number, err)
+			}
+		}
+
+		if _, err := tx.Exec("DELETE FROM draw_winner_locations WHERE lottery_type = ? AND number = ?", string(ltype), draw.Number); err != nil {
+			return 0, fmt.Errorf("erro ao limpar ganhadores do sorteio %d: %w", draw.Number, err)
+		}
+		for i, location := range draw.WinnerLocations {
+			position := location.Position
+			if position == 0 {
+				position = i + 1
+			}
+			_, err := tx.Exec(`
+				INSERT OR REPLACE INTO draw_winner_locations (lottery_type, number, position, winners, city, state, outlet, series)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+			`, string(ltype), draw.Number, position, location.Winners, location.City, location.State, location.Outlet, location.Series)
+			if err != nil {
+				return 0, fmt.Errorf("erro ao gravar ganhadores do sorteio %d: %w", draw.Number, err)
+			}
+		}
+		saved++
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("erro ao confirmar sorteios: %w", err)
+	}
+	return saved, nil
+}
+
+// LatestDraws retorna os count sorteios mais recentes guardados, do mais novo para o mais antigo
+func (s *DrawStore) LatestDraws(ltype lottery.LotteryType, count int) ([]lottery.Draw, error) {
+	return s.queryDraws(ltype, `
+		SELECT draw_json FROM draws
+		WHERE lottery_type = ?
+		ORDER BY number DESC LIMIT ?
+	`, string(ltype), count)
+}
+
+// AllDraws retorna todo o histórico guardado, do concurso mais antigo para o mais novo
+func (s *DrawStore) AllDraws(ltype lottery.LotteryType) ([]lottery.Draw, error) {
+	return s.queryDraws(ltype, `
+		SELECT draw_json FROM draws
+		WHERE lottery_type = ?
+		ORDER BY number ASC
+	`, string(ltype))
+}
+
+// queryDraws decodifica os sorteios retornados pela consulta e carrega suas faixas
+func (s *DrawStore) queryDraws(ltype lottery.LotteryType, query string, args ...interface{}) ([]lottery.Draw, error) {
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar sorteios guardados: %w", err)
+	}
+	defer rows.Close()
+
+	var draws []lottery.Draw
+	for rows.Next() {
+		var drawJSON string
+		if err := rows.Scan(&drawJSON); err != nil {
+			return nil, fmt.Errorf("erro ao fazer scan do sorteio: %w", err)
+		}
+
+		var draw lottery.Draw
+		if err := json.Unmarshal([]byte(drawJSON), &draw); err != nil {
+			return nil, fmt.Errorf("erro ao decodificar sorteio guardado: %w", err)
+		}
+		draws = append(draws, draw)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("erro ao buscar sorteios guardados: %w", err)
+	}
+
+	if err := s.loadDetails(ltype, draws); err != nil {
+		return nil, err
+	}
+	return draws, nil
+}
+
+// GetDraw retorna um sorteio guardado, ou nil se ele ainda não foi buscado
+func (s *DrawStore) GetDraw(ltype lottery.LotteryType, number int) (*lottery.Draw, error) {
+	var drawJSON string
+	err := s.db.QueryRow("SELECT draw_json FROM draws WHERE lottery_type = ? AND number = ?", string(ltype), number).Scan(&drawJSON)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar sorteio %d guardado: %w", number, err)
+	}
+
+	var draw lottery.Draw
+	if err := json.Unmarshal([]byte(drawJSON), &draw); err != nil {
+		return nil, fmt.Errorf("erro ao decodificar sorteio %d guardado: %w", number, err)
+	}
+	draws := []lottery.Draw{draw}
+	if err := s.loadDetails(ltype, draws); err != nil {
+		return nil, err
+	}
+	return &draws[0], nil
+}
+
+// MaxNumber retorna o maior concurso guardado (zero se não houver nenhum)
+func (s *DrawStore) MaxNumber(ltype lottery.LotteryType) (int, error) {
+	var number sql.NullInt64
+	if err := s.db.QueryRow("SELECT MAX(number) FROM draws WHERE lottery_type = ?", string(ltype)).Scan(&number); err != nil {
+		return 0, fmt.Errorf("erro ao buscar último concurso guardado: %w", err)
+	}
+	return int(number.Int64), nil
+}
+
+// StoredNumbers retorna os concursos com resultado oficial guardados no intervalo [from, to]
+// Resultados manuais ficam de fora para serem buscados de novo (e substituídos) quando a API responder
+func (s *DrawStore) StoredNumbers(ltype lottery.LotteryType, from, to int) (map[int]bool, error) {
+	rows, err := s.db.Query(`
+		SELECT number FROM draws
+		WHERE lottery_type = ? AND number BETWEEN ? AND ? AND manual = 0
+	`, string(ltype), from, to)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar concursos guardados: %w", err)
+	}
+	defer rows.Close()
+
+	numbers := make(map[int]bool)
+	for rows.Next() {
+		var number int
+		if err := rows.Scan(&number); err != nil {
+			return nil, fmt.Errorf("erro ao fazer scan do concurso: %w", err)
+		}
+		numbers[number] = true
+	}
+	return numbers, rows.Err()
+}
+
+// Count retorna quantos concursos estão guardados
+func (s *DrawStore) Count(ltype lottery.LotteryType) (int, error) {
+	var count int
+	if err := s.db.QueryRow("SELECT COUNT(*) FROM draws WHERE lottery_type = ?", string(ltype)).Scan(&count); err != nil {
+		return 0, fmt.Errorf("erro ao contar sorteios guardados: %w", err)
+	}
+	return count, nil
+}
+
+// loadDetails preenche as faixas de prêmio e as cidades dos ganhadores dos sorteios
+// Faz uma consulta por tabela para todo o intervalo de concursos, não uma por sorteio
+func (s *DrawStore) loadDetails(ltype lottery.LotteryType, draws []lottery.Draw) error {
+	if len(draws) == 0 {
+		return nil
+	}
+
+	byNumber := make(map[int]*lottery.Draw, len(draws))
+	from, to := draws[0].Number, draws[0].Number
+	for i := range draws {
+		byNumber[draws[i].Number] = &draws[i]
+		if draws[i].Number < from {
+			from = draws[i].Number
+		}
+		if draws[i].Number > to {
+			to = draws[i].Number
+		}
+	}
+
+	if err := s.loadPrizeTiers(ltype, from, to, byNumber); err != nil {
+		return err
+	}
+	return s.loadWinnerLocations(ltype, from, to, byNumber)
+}
+
+// loadPrizeTiers preenche as faixas de prêmio dos sorteios do intervalo a partir da tabela de faixas
+func (s *DrawStore) loadPrizeTiers(ltype lottery.LotteryType, from, to int, byNumber map[int]*lottery.Draw) error {
+	rows, err := s.db.Query(`
+		SELECT number, faixa, description, winners, prize FROM draw_prize_tiers
+		WHERE lottery_type = ? AND number BETWEEN ? AND ?
+		ORDER BY number, faixa
+	`, string(ltype), from, to)
+	if err != nil {
+		return fmt.Errorf("erro ao buscar faixas dos sorteios %d a %d: %w", from, to, err)
+	}
+	defer rows.Close()
+
+	winners := make(map[int][]lottery.Winner)
+	for rows.Next() {
+		var number int
+		var winner lottery.Winner
+		if err := rows.Scan(&number, &winner.Tier, &winner.Description, &winner.Winners, &winner.Prize); err != nil {
+			return fmt.Errorf("erro ao fazer scan da faixa: %w", err)
+		}
+		if byNumber[number] != nil {
+			winners[number] = append(winners[number], winner)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("erro ao buscar faixas dos sorteios %d a %d: %w", from, to, err)
+	}
+
+	for number, tiers := range winners {
+		byNumber[number].Winners = tiers
+	}
+	return nil
+}
+
+// loadWinnerLocations preenche as cidades dos ganhadores dos sorteios do intervalo a partir da tabela de ganhadores
+func (s *DrawStore) loadWinnerLocations(ltype lottery.LotteryType, from, to int, byNumber map[int]*lottery.Draw) error {
+	rows, err := s.db.Query(`
+		SELECT number, position, winners, city, state, outlet, series FROM draw_winner_locations
+		WHERE lottery_type = ? AND number BETWEEN ? AND ?
+		ORDER BY number, position
+	`, string(ltype), from, to)
+	if err != nil {
+		return fmt.Errorf("erro ao buscar ganhadores dos sorteios %d a %d: %w", from, to, err)
+	}
+	defer rows.Close()
+
+	locations := make(map[int][]lottery.WinnerLocation)
+	for rows.Next() {
+		var number int
+		var location lottery.WinnerLocation
+		if err := rows.Scan(&number, &location.Position, &location.Winners, &location.City, &location.State, &location.Outlet, &location.Series); err != nil {
+			return fmt.Errorf("erro ao fazer scan do ganhador: %w", err)
+		}
+		if byNumber[number] != nil {
+			locations[number] = append(locations[number], location)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("erro ao buscar ganhadores dos sorteios %d a %d: %w", from, to, err)
+	}
+
+	for number, list := range locations {
+		byNumber[number].WinnerLocations = list
+	}
+	return nil
+}
+
+// isComplete indica se o sorteio já foi apurado e pode ser guardado em definitivo
+// Na Loteca a grade é publicada antes dos jogos; só vale quando todos os placares saíram
+func isComplete(ltype lottery.LotteryType, draw lottery.Draw) bool {
+	if draw.Number <= 0 {
+		return false
+	}
+	if lottery.GetRules(ltype).IsMatchPool() {
+		outcomes := draw.MatchOutcomes()
+		for _, outcome := range outcomes {
+			if outcome == "" {
+				return false
+			}
+		}
+		return len(outcomes) > 0
+	}
+	return len(draw.Numbers) > 0
+}
@@ -0,0 +1,93 @@
+package data
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"lottery-optimizer-gui/internal/lottery"
+)
+
+// newTestStore abre um banco de sorteios vazio em um diretório temporário
+func newTestStore(t *testing.T) *DrawStore {
+	t.Helper()
+	store, err := NewDrawStore(filepath.Join(t.TempDir(), "draws.db"))
+	if err != nil {
+		t.Fatalf("NewDrawStore: %v", err)
+	}
+	t.Cleanup(func() { store.db.Close() })
+	return store
+}
+
+// storedDrawWithPrizes monta um sorteio da Quina com duas faixas e uma cidade ganhadora
+func storedDrawWithPrizes(number int) lottery.Draw {
+	return lottery.Draw{
+		Number:  number,
+		Numbers: lottery.StringIntSlice{1, 2, 3, 4, number},
+		Winners: []lottery.Winner{
+			{Description: "5 acertos", Winners: 1, Prize: float64(number) * 1000},
+			{Description: "4 acertos", Winners: number, Prize: 500},
+		},
+		WinnerLocations: []lottery.WinnerLocation{{Winners: 1, City: "SÃO PAULO", State: "SP"}},
+	}
+}
+
+func TestDrawStoreLoadsDetails(t *testing.T) {
+	store := newTestStore(t)
+
+	var draws []lottery.Draw
+	for number := 10; number <= 14; number++ {
+		draws = append(draws, storedDrawWithPrizes(number))
+	}
+	// Sorteio antigo sem faixas: não pode herdar as faixas dos vizinhos
+	draws = append(draws, lottery.Draw{Number: 9, Numbers: lottery.StringIntSlice{5, 6, 7, 8, 9}})
+	saved, err := store.SaveDraws(lottery.Quina, draws)
+	if err != nil {
+		t.Fatalf("SaveDraws: %v", err)
+	}
+	if saved != len(draws) {
+		t.Fatalf("SaveDraws() gravou %d, want %d", saved, len(draws))
+	}
+
+	latest, err := store.LatestDraws(lottery.Quina, 3)
+	if err != nil {
+		t.Fatalf("LatestDraws: %v", err)
+	}
+	var numbers []int
+	for _, draw := range latest {
+		numbers = append(numbers, draw.Number)
+	}
+	if !reflect.DeepEqual(numbers, []int{14, 13, 12}) {
+		t.Fatalf("LatestDraws() = concursos %v, want [14 13 12]", numbers)
+	}
+
+	all, err := store.AllDraws(lottery.Quina)
+	if err != nil {
+		t.Fatalf("AllDraws: %v", err)
+	}
+	if len(all) != 6 || all[0].Number != 9 || len(all[0].Winners) != 0 {
+		t.Fatalf("AllDraws() = %d sorteios, primeiro %d com faixas %v", len(all), all[0].Number, all[0].Winners)
+	}
+
+	for _, draw := range append(latest, all[1:]...) {
+		// A tabela de faixas numera as faixas pela ordem em que vieram
+		want := []lottery.Winner{
+			{Tier: 1, Description: "5 acertos", Winners: 1, Prize: float64(draw.Number) * 1000},
+			{Tier: 2, Description: "4 acertos", Winners: draw.Number, Prize: 500},
+		}
+		if !reflect.DeepEqual(draw.Winners, want) {
+			t.Errorf("concurso %d: Winners = %+v, want %+v", draw.Number, draw.Winners, want)
+		}
+		if len(draw.WinnerLocations) != 1 || draw.WinnerLocations[0].Position != 1 {
+			t.Errorf("concurso %d: WinnerLocations = %+v", draw.Number, draw.WinnerLocations)
+		}
+	}
+
+	draw, err := store.GetDraw(lottery.Quina, 12)
+	if err != nil {
+		t.Fatalf("GetDraw: %v", err)
+	}
+	if draw == nil || len(draw.Winners) != 2 || draw.Winners[1].Winners != 12 {
+		t.Errorf("GetDraw(12) = %+v", draw)
+	}
+}
@@ -0,0 +1,493 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"lottery-optimizer-gui/internal/logs"
+	"lottery-optimizer-gui/internal/models"
+
+	"github.com/google/uuid"
+)
+
+// createPoolTables cria as tabelas do bolão: o bolão, seus participantes e o extrato
+func (sg *SavedGamesDB) createPoolTables() error {
+	query := `
+	CREATE TABLE IF NOT EXISTS pools (
+		id TEXT PRIMARY KEY,
+		name TEXT NOT NULL,
+		organizer TEXT NOT NULL DEFAULT '',
+		organizer_fee REAL NOT NULL DEFAULT 0, -- % sobre o custo dos jogos
+		status TEXT NOT NULL DEFAULT 'open',   -- open, closed
+		created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+	);
+
+	CREATE TABLE IF NOT EXISTS pool_participants (
+		id TEXT PRIMARY KEY,
+		pool_id TEXT NOT NULL,
+		name TEXT NOT NULL,
+		quotas INTEGER NOT NULL,
+		created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+	);
+
+	CREATE TABLE IF NOT EXISTS pool_ledger (
+		id TEXT PRIMARY KEY,
+		pool_id TEXT NOT NULL,
+		participant_id TEXT NOT NULL,
+		game_id TEXT NOT NULL DEFAULT '',
+		kind TEXT NOT NULL, -- payment, prize, payout
+		amount REAL NOT NULL,
+		description TEXT NOT NULL DEFAULT '',
+		created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_saved_games_pool_id ON saved_games(pool_id);
+	CREATE INDEX IF NOT EXISTS idx_pool_participants_pool_id ON pool_participants(pool_id);
+	CREATE INDEX IF NOT EXISTS idx_pool_ledger_pool_id ON pool_ledger(pool_id);
+	-- Cada jogo premiado credita o participante uma única vez, mesmo se conferido de novo
+	CREATE UNIQUE INDEX IF NOT EXISTS idx_pool_ledger_prize ON pool_ledger(participant_id, game_id) WHERE kind = 'prize';
+	`
+
+	if _, err := sg.db.Exec(query); err != nil {
+		return fmt.Errorf("erro ao criar tabelas de bolão: %w", err)
+	}
+	return nil
+}
+
+// CreatePool cria um novo bolão aberto
+func (sg *SavedGamesDB) CreatePool(request models.CreatePoolRequest) (*models.Pool, error) {
+	name := strings.TrimSpace(request.Name)
+	if name == "" {
+		return nil, fmt.Errorf("nome do bolão não informado")
+	}
+	if request.OrganizerFee < 0 || request.OrganizerFee > 100 {
+		return nil, fmt.Errorf("taxa do organizador deve estar entre 0%% e 100%%, recebido %.2f%%", request.OrganizerFee)
+	}
+
+	pool := &models.Pool{
+		ID:           uuid.New().String(),
+		Name:         name,
+		Organizer:    strings.TrimSpace(request.Organizer),
+		OrganizerFee: request.OrganizerFee,
+		Status:       "open",
+		CreatedAt:    time.Now(),
+	}
+
+	_, err := sg.db.Exec(`
+		INSERT INTO pools (id, name, organizer, organizer_fee, status, created_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`, pool.ID, pool.Name, pool.Organizer, pool.OrganizerFee, pool.Status, pool.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao criar bolão: %w", err)
+	}
+
+	logs.LogDatabase("🤝 Bolão criado: %s (%s), taxa do organizador %.2f%%", pool.Name, pool.ID, pool.OrganizerFee)
+	return pool, nil
+}
+
+// GetPools lista os bolões com participantes, jogos e totais
+func (sg *SavedGamesDB) GetPools() ([]models.Pool, error) {
+	rows, err := sg.db.Query(`
+		SELECT id, name, organizer, organizer_fee, status, created_at
+		FROM pools ORDER BY created_at DESC
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar bolões: %w", err)
+	}
+	defer rows.Close()
+
+	var pools []models.Pool
+	for rows.Next() {
+		var pool models.Pool
+		if err := rows.Scan(&pool.ID, &pool.Name, &pool.Organizer, &pool.OrganizerFee, &pool.Status, &pool.CreatedAt); err != nil {
+			return nil, fmt.Errorf("erro ao fazer scan do bolão: %w", err)
+		}
+		pools = append(pools, pool)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("erro ao buscar bolões: %w", err)
+	}
+
+	for i := range pools {
+		if err := sg.loadPool(&pools[i]); err != nil {
+			return nil, err
+		}
+	}
+	return pools, nil
+}
+
+// GetPool busca um bolão pelo ID com participantes, jogos e totais
+func (sg *SavedGamesDB) GetPool(poolID string) (*models.Pool, error) {
+	var pool models.Pool
+	err := sg.db.QueryRow(`
+		SELECT id, name, organizer, organizer_fee, status, created_at
+		FROM pools WHERE id = ?
+	`, poolID).Scan(&pool.ID, &pool.Name, &pool.Organizer, &pool.OrganizerFee, &pool.Status, &pool.CreatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("bolão não encontrado")
+		}
+		return nil, fmt.Errorf("erro ao buscar bolão: %w", err)
+	}
+
+	if err := sg.loadPool(&pool); err != nil {
+		return nil, err
+	}
+	return &pool, nil
+}
+
+// loadPool carrega participantes, jogos e totais do bolão
+func (sg *SavedGamesDB) loadPool(pool *models.Pool) error {
+	participants, err := sg.getPoolParticipants(pool.ID)
+	if err != nil {
+		return err
+	}
+	pool.Participants = participants
+
+	games, err := sg.GetSavedGames(models.SavedGamesFilter{PoolID: pool.ID})
+	if err != nil {
+		return fmt.Errorf("erro ao buscar jogos do bolão: %w", err)
+	}
+	pool.Games = games
+
+	entries, err := sg.getPoolLedger(pool.ID)
+	if err != nil {
+		return err
+	}
+	pool.Summary = summarizePool(pool, entries)
+	return nil
+}
+
+// SetPoolStatus abre ou fecha o bolão; bolões fechados não aceitam novos jogos nem participantes
+func (sg *SavedGamesDB) SetPoolStatus(poolID, status string) error {
+	if status != "open" && status != "closed" {
+		return fmt.Errorf("status de bolão inválido: %s", status)
+	}
+
+	result, err := sg.db.Exec("UPDATE pools SET status = ? WHERE id = ?", status, poolID)
+	if err != nil {
+		return fmt.Errorf("erro ao atualizar bolão: %w", err)
+	}
+	if affected, _ := result.RowsAffected(); affected == 0 {
+		return fmt.Errorf("bolão não encontrado")
+	}
+	return nil
+}
+
+// AddPoolParticipant inclui um participante com suas cotas em um bolão aberto
+func (sg *SavedGamesDB) AddPoolParticipant(poolID, name string, quotas int) (*models.PoolParticipant, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("nome do participante não informado")
+	}
+	if quotas <= 0 {
+		return nil, fmt.Errorf("quantidade de cotas deve ser maior que zero")
+	}
+	if err := sg.RequireOpenPool(poolID); err != nil {
+		return nil, err
+	}
+
+	participant := &models.PoolParticipant{
+		ID:        uuid.New().String(),
+		PoolID:    poolID,
+		Name:      name,
+		Quotas:    quotas,
+		CreatedAt: time.Now(),
+	}
+
+	_, err := sg.db.Exec(`
+		INSERT INTO pool_participants (id, pool_id, name, quotas, created_at)
+		VALUES (?, ?, ?, ?, ?)
+	`, participant.ID, participant.PoolID, participant.Name, participant.Quotas, participant.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao adicionar participante: %w", err)
+	}
+
+	logs.LogDatabase("👤 Participante %s entrou no bolão %s com %d cotas", participant.Name, poolID, quotas)
+	return participant, nil
+}
+
+// AddGameToPool inclui um jogo salvo em um bolão aberto
+func (sg *SavedGamesDB) AddGameToPool(poolID, gameID string) error {
+	if err := sg.RequireOpenPool(poolID); err != nil {
+		return err
+	}
+
+	result, err := sg.db.Exec("UPDATE saved_games SET pool_id = ? WHERE id = ?", poolID, gameID)
+	if err != nil {
+		return fmt.Errorf("erro ao incluir jogo no bolão: %w", err)
+	}
+	if affected, _ := result.RowsAffected(); affected == 0 {
+		return fmt.Errorf("jogo não encontrado")
+	}
+
+	logs.LogDatabase("🎫 Jogo %s incluído no bolão %s", gameID, poolID)
+	return nil
+}
+
+// RecordPoolEntry lança um pagamento de cotas ou um repasse de prêmio no extrato do participante
+func (sg *SavedGamesDB) RecordPoolEntry(poolID, participantID, kind string, amount float64, description string) (*models.PoolLedgerEntry, error) {
+	if kind != models.LedgerPayment && kind != models.LedgerPayout {
+		return nil, fmt.Errorf("tipo de lançamento inválido: %s", kind)
+	}
+	if amount <= 0 {
+		return nil, fmt.Errorf("valor do lançamento deve ser maior que zero")
+	}
+
+	var exists int
+	err := sg.db.QueryRow("SELECT COUNT(*) FROM pool_participants WHERE id = ? AND pool_id = ?", participantID, poolID).Scan(&exists)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar participante: %w", err)
+	}
+	if exists == 0 {
+		return nil, fmt.Errorf("participante não encontrado no bolão")
+	}
+
+	entry := &models.PoolLedgerEntry{
+		ID:            uuid.New().String(),
+		PoolID:        poolID,
+		ParticipantID: participantID,
+		Kind:          kind,
+		Amount:        roundCents(amount),
+		Description:   description,
+		CreatedAt:     time.Now(),
+	}
+
+	_, err = sg.db.Exec(`
+		INSERT INTO pool_ledger (id, pool_id, participant_id, game_id, kind, amount, description, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	`, entry.ID, entry.PoolID, entry.ParticipantID, entry.GameID, entry.Kind, entry.Amount, entry.Description, entry.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao registrar lançamento: %w", err)
+	}
+
+	logs.LogDatabase("💵 Lançamento %s de R$ %.2f no bolão %s", kind, entry.Amount, poolID)
+	return entry, nil
+}
+
+// creditPoolPrize divide o prêmio de um jogo do bolão entre os participantes, proporcional às cotas
+// A divisão é feita em centavos; os centavos que sobram vão para quem tem mais cotas
+// Conferir o jogo de novo substitui o crédito anterior, então o extrato reflete sempre o último resultado;
+// prêmio zero só remove o crédito anterior (ex: resultado manual premiado corrigido para sem prêmio)
+func creditPoolPrize(tx *sql.Tx, poolID, gameID string, prize float64, participants []models.PoolParticipant) error {
+	// Remove o crédito de uma conferência anterior (ex: resultado manual corrigido pelo oficial)
+	if _, err := tx.Exec("DELETE FROM pool_ledger WHERE pool_id = ? AND game_id = ? AND kind = ?", poolID, gameID, models.LedgerPrize); err != nil {
+		return fmt.Errorf("erro ao remover prêmio anterior: %w", err)
+	}
+	if prize <= 0 {
+		return nil
+	}
+
+	shares := splitByQuotas(prize, participants)
+	if len(shares) == 0 {
+		logs.LogDatabase("⚠️ Bolão %s sem participantes: prêmio de R$ %.2f não dividido", poolID, prize)
+		return nil
+	}
+
+	totalQuotas := 0
+	for _, participant := range participants {
+		totalQuotas += participant.Quotas
+	}
+
+	now := time.Now()
+	for i, participant := range participants {
+		_, err := tx.Exec(`
+			INSERT INTO pool_ledger (id, pool_id, participant_id, game_id, kind, amount, description, created_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		`, uuid.New().String(), poolID, participant.ID, gameID, models.LedgerPrize, shares[i],
+			fmt.Sprintf("Prêmio de R$ %.2f: %d de %d cotas", prize, participant.Quotas, totalQuotas), now)
+		if err != nil {
+			return fmt.Errorf("erro ao creditar prêmio: %w", err)
+		}
+	}
+
+	logs.LogDatabase("🏆 Prêmio de R$ %.2f do jogo %s dividido entre %d participantes", prize, gameID, len(participants))
+	return nil
+}
+
+// GetPoolStatements monta o extrato e o saldo de cada participante do bolão
+func (sg *SavedGamesDB) GetPoolStatements(poolID string) ([]models.PoolStatement, error) {
+	pool, err := sg.GetPool(poolID)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := sg.getPoolLedger(poolID)
+	if err != nil {
+		return nil, err
+	}
+
+	byParticipant := make(map[string][]models.PoolLedgerEntry)
+	for _, entry := range entries {
+		byParticipant[entry.ParticipantID] = append(byParticipant[entry.ParticipantID], entry)
+	}
+
+	statements := make([]models.PoolStatement, 0, len(pool.Participants))
+	for _, participant := range pool.Participants {
+		statement := models.PoolStatement{
+			Participant: participant,
+			Entries:     byParticipant[participant.ID],
+		}
+		if pool.Summary.TotalQuotas > 0 {
+			statement.Share = float64(participant.Quotas) / float64(pool.Summary.TotalQuotas)
+		}
+		statement.AmountDue = roundCents(float64(participant.Quotas) * pool.Summary.QuotaPrice)
+
+		for _, entry := range statement.Entries {
+			switch entry.Kind {
+			case models.LedgerPayment:
+				statement.Paid += entry.Amount
+			case models.LedgerPrize:
+				statement.Prizes += entry.Amount
+			case models.LedgerPayout:
+				statement.PaidOut += entry.Amount
+			}
+		}
+
+		statement.Balance = roundCents(statement.Paid - statement.AmountDue + statement.Prizes - statement.PaidOut)
+		statements = append(statements, statement)
+	}
+
+	return statements, nil
+}
+
+// RequireOpenPool verifica se o bolão existe e ainda aceita alterações
+func (sg *SavedGamesDB) RequireOpenPool(poolID string) error {
+	var status string
+	err := sg.db.QueryRow("SELECT status FROM pools WHERE id = ?", poolID).Scan(&status)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("bolão não encontrado")
+		}
+		return fmt.Errorf("erro ao buscar bolão: %w", err)
+	}
+	if status != "open" {
+		return fmt.Errorf("bolão fechado não aceita alterações")
+	}
+	return nil
+}
+
+// getPoolParticipants busca os participantes do bolão na ordem de entrada
+func (sg *SavedGamesDB) getPoolParticipants(poolID string) ([]models.PoolParticipant, error) {
+	rows, err := sg.db.Query(`
+		SELECT id, pool_id, name, quotas, created_at
+		FROM pool_participants WHERE pool_id = ? ORDER BY created_at, id
+	`, poolID)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar participantes: %w", err)
+	}
+	defer rows.Close()
+
+	var participants []models.PoolParticipant
+	for rows.Next() {
+		var participant models.PoolParticipant
+		if err := rows.Scan(&participant.ID, &participant.PoolID, &participant.Name, &participant.Quotas, &participant.CreatedAt); err != nil {
+			return nil, fmt.Errorf("erro ao fazer scan do participante: %w", err)
+		}
+		participants = append(participants, participant)
+	}
+	return participants, rows.Err()
+}
+
+// getPoolLedger busca todos os lançamentos do bolão em ordem cronológica
+func (sg *SavedGamesDB) getPoolLedger(poolID string) ([]models.PoolLedgerEntry, error) {
+	rows, err := sg.db.Query(`
+		SELECT id, pool_id, participant_id, game_id, kind, amount, description, created_at
+		FROM pool_ledger WHERE pool_id = ? ORDER BY created_at, id
+	`, poolID)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar extrato do bolão: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []models.PoolLedgerEntry
+	for rows.Next() {
+		var entry models.PoolLedgerEntry
+		if err := rows.Scan(&entry.ID, &entry.PoolID, &entry.ParticipantID, &entry.GameID, &entry.Kind,
+			&entry.Amount, &entry.Description, &entry.CreatedAt); err != nil {
+			return nil, fmt.Errorf("erro ao fazer scan do lançamento: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, rows.Err()
+}
+
+// summarizePool totaliza custo, taxa do organizador, valor da cota e prêmios
+// A taxa do organizador é cobrada sobre o custo dos jogos e embutida no valor da cota
+func summarizePool(pool *models.Pool, entries []models.PoolLedgerEntry) *models.PoolSummary {
+	summary := &models.PoolSummary{}
+
+	for _, participant := range pool.Participants {
+		summary.TotalQuotas += participant.Quotas
+	}
+	for _, game := range pool.Games {
+		summary.GamesCost += game.Cost
+	}
+
+	summary.OrganizerFee = roundCents(summary.GamesCost * pool.OrganizerFee / 100)
+	summary.TotalCost = roundCents(summary.GamesCost + summary.OrganizerFee)
+	if summary.TotalQuotas > 0 {
+		summary.QuotaPrice = summary.TotalCost / float64(summary.TotalQuotas)
+	}
+
+	for _, entry := range entries {
+		switch entry.Kind {
+		case models.LedgerPayment:
+			summary.TotalCollected += entry.Amount
+		case models.LedgerPrize:
+			summary.TotalPrize += entry.Amount
+		case models.LedgerPayout:
+			summary.TotalPaidOut += entry.Amount
+		}
+	}
+
+	return summary
+}
+
+// splitByQuotas divide um valor entre os participantes pelas cotas, em centavos
+// Retorna a parte de cada participante na mesma ordem; a soma das partes é exatamente o valor
+func splitByQuotas(amount float64, participants []models.PoolParticipant) []float64 {
+	totalQuotas := 0
+	for _, participant := range participants {
+		totalQuotas += participant.Quotas
+	}
+	if totalQuotas == 0 {
+		return nil
+	}
+
+	totalCents := int64(math.Round(amount * 100))
+	cents := make([]int64, len(participants))
+	var distributed int64
+	for i, participant := range participants {
+		cents[i] = totalCents * int64(participant.Quotas) / int64(totalQuotas)
+		distributed += cents[i]
+	}
+
+	// Centavos restantes, um por participante, começando por quem tem mais cotas
+	order := make([]int, len(participants))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return participants[order[a]].Quotas > participants[order[b]].Quotas
+	})
+	for i := 0; distributed < totalCents; i++ {
+		cents[order[i%len(order)]]++
+		distributed++
+	}
+
+	shares := make([]float64, len(participants))
+	for i, c := range cents {
+		shares[i] = float64(c) / 100
+	}
+	return shares
+}
+
+// roundCents arredonda um valor em reais para centavos
+func roundCents(value float64) float64 {
+	return math.Round(value*100) / 100
+}
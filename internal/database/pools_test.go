@@ -0,0 +1,148 @@
+package database
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"lottery-optimizer-gui/internal/lottery"
+	"lottery-optimizer-gui/internal/models"
+)
+
+// newTestDB abre um banco de jogos salvos vazio em um diretório temporário
+func newTestDB(t *testing.T) *SavedGamesDB {
+	t.Helper()
+	db, err := NewSavedGamesDB(filepath.Join(t.TempDir(), "saved_games.db"))
+	if err != nil {
+		t.Fatalf("NewSavedGamesDB: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func TestPoolPrizeAfterCorrection(t *testing.T) {
+	tests := []struct {
+		name     string
+		reopen   bool // Correção do resultado manual reabre o jogo antes da nova conferência
+		first    float64
+		second   float64
+		wantAna  float64
+		wantBeto float64
+	}{
+		{"correção reduz o prêmio", true, 1000, 400, 100, 300},
+		{"correção aumenta o prêmio", true, 400, 1000, 250, 750},
+		{"conferência repetida sem correção", false, 1000, 1000, 250, 750},
+		{"conferência repetida com outro valor", false, 1000, 10, 2.5, 7.5},
+		{"correção tira o prêmio", true, 1000, 0, 0, 0},
+		{"conferência repetida sem prêmio", false, 1000, 0, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := newTestDB(t)
+
+			pool, err := db.CreatePool(models.CreatePoolRequest{Name: "Bolão do escritório"})
+			if err != nil {
+				t.Fatalf("CreatePool: %v", err)
+			}
+			ana, err := db.AddPoolParticipant(pool.ID, "Ana", 1)
+			if err != nil {
+				t.Fatalf("AddPoolParticipant: %v", err)
+			}
+			beto, err := db.AddPoolParticipant(pool.ID, "Beto", 3)
+			if err != nil {
+				t.Fatalf("AddPoolParticipant: %v", err)
+			}
+
+			game, err := db.SaveGame(models.SaveGameRequest{
+				LotteryType:   string(lottery.MegaSena),
+				Numbers:       []int{1, 2, 3, 4, 5, 6},
+				ExpectedDraw:  "2026-01-03",
+				ContestNumber: 2900,
+				PoolID:        pool.ID,
+			})
+			if err != nil {
+				t.Fatalf("SaveGame: %v", err)
+			}
+
+			if err := db.UpdateGameResult(game.ID, poolResult(tt.first)); err != nil {
+				t.Fatalf("UpdateGameResult: %v", err)
+			}
+
+			if tt.reopen {
+				count, err := db.ReopenCheckedGames(lottery.MegaSena, game.ContestNumber)
+				if err != nil {
+					t.Fatalf("ReopenCheckedGames: %v", err)
+				}
+				if count != 1 {
+					t.Fatalf("ReopenCheckedGames reabriu %d jogos, want 1", count)
+				}
+				if got := prizesByParticipant(t, db, pool.ID); len(got) != 0 {
+					t.Fatalf("prêmios após reabrir = %v, want nenhum", got)
+				}
+			}
+
+			if err := db.UpdateGameResult(game.ID, poolResult(tt.second)); err != nil {
+				t.Fatalf("UpdateGameResult: %v", err)
+			}
+
+			got := prizesByParticipant(t, db, pool.ID)
+			if got[ana.ID] != tt.wantAna || got[beto.ID] != tt.wantBeto {
+				t.Errorf("prêmios = Ana %.2f, Beto %.2f, want Ana %.2f, Beto %.2f",
+					got[ana.ID], got[beto.ID], tt.wantAna, tt.wantBeto)
+			}
+		})
+	}
+}
+
+// poolResult monta o resultado conferido de um jogo com o prêmio informado
+func poolResult(prize float64) *models.GameResult {
+	return &models.GameResult{ContestNumber: 2900, IsWinner: prize > 0, PrizeAmount: prize}
+}
+
+// prizesByParticipant soma os créditos de prêmio do extrato de cada participante
+func prizesByParticipant(t *testing.T, db *SavedGamesDB, poolID string) map[string]float64 {
+	t.Helper()
+	statements, err := db.GetPoolStatements(poolID)
+	if err != nil {
+		t.Fatalf("GetPoolStatements: %v", err)
+	}
+
+	prizes := make(map[string]float64)
+	for _, statement := range statements {
+		if statement.Prizes != 0 {
+			prizes[statement.Participant.ID] = statement.Prizes
+		}
+	}
+	return prizes
+}
+
+func TestSplitByQuotas(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount float64
+		quotas []int
+		want   []float64
+	}{
+		{"divisão exata", 100, []int{1, 3}, []float64{25, 75}},
+		{"centavo que sobra vai para o primeiro empatado", 10, []int{1, 1, 1}, []float64{3.34, 3.33, 3.33}},
+		{"centavo que sobra vai para quem tem mais cotas", 0.05, []int{1, 2}, []float64{0.01, 0.04}},
+		{"vários centavos restantes", 1, []int{1, 1, 1, 1, 1, 1, 1}, []float64{0.15, 0.15, 0.14, 0.14, 0.14, 0.14, 0.14}},
+		{"prêmio zero", 0, []int{2, 2}, []float64{0, 0}},
+		{"sem participantes", 100, nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			participants := make([]models.PoolParticipant, len(tt.quotas))
+			for i, quotas := range tt.quotas {
+				participants[i] = models.PoolParticipant{Quotas: quotas}
+			}
+
+			got := splitByQuotas(tt.amount, participants)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("splitByQuotas(%.2f, %v) = %v, want %v", tt.amount, tt.quotas, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"sort"
+	"time"
+
+	"lottery-optimizer-gui/internal/logs"
+	"lottery-optimizer-gui/internal/lottery"
+	"lottery-optimizer-gui/internal/models"
+
+	"github.com/google/uuid"
+)
+
+// createRecurringBetsTable cria a tabela das Teimosinhas (apostas repetidas em concursos consecutivos)
+func (sg *SavedGamesDB) createRecurringBetsTable() error {
+	query := `
+	CREATE TABLE IF NOT EXISTS recurring_bets (
+		id TEXT PRIMARY KEY,
+		lottery_type TEXT NOT NULL,
+		first_contest INTEGER NOT NULL,
+		contests INTEGER NOT NULL,
+		total_cost REAL NOT NULL DEFAULT 0,
+		created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_saved_games_recurring_id ON saved_games(recurring_id);
+	`
+
+	if _, err := sg.db.Exec(query); err != nil {
+		return fmt.Errorf("erro ao criar tabela recurring_bets: %w", err)
+	}
+	return nil
+}
+
+// SaveRecurringBet salva uma Teimosinha: um jogo pendente para cada concurso consecutivo
+// A sequência é paga na compra, por isso todos os concursos usam o preço em vigor no primeiro
+func (sg *SavedGamesDB) SaveRecurringBet(request models.SaveGameRequest) (*models.RecurringBet, error) {
+	if err := lottery.ValidateRecurringContests(request.Contests); err != nil {
+		return nil, err
+	}
+
+	firstDraw, err := time.Parse("2006-01-02", request.ExpectedDraw)
+	if err != nil {
+		return nil, fmt.Errorf("data do sorteio inválida: %s", request.ExpectedDraw)
+	}
+
+	bet := &models.RecurringBet{
+		ID:           uuid.New().String(),
+		LotteryType:  request.LotteryType,
+		FirstContest: request.ContestNumber,
+		Contests:     request.Contests,
+		CreatedAt:    time.Now(),
+	}
+
+	logs.LogDatabase("🔁 Salvando Teimosinha %s: %s, concursos %d a %d",
+		bet.ID, bet.LotteryType, bet.FirstContest, bet.FirstContest+bet.Contests-1)
+
+	tx, err := sg.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("erro ao iniciar transação: %w", err)
+	}
+	defer tx.Rollback()
+
+	var contestCost float64
+	for i, drawDate := range lottery.NextDrawDates(lottery.LotteryType(request.LotteryType), firstDraw, request.Contests) {
+		contestRequest := request
+		contestRequest.ContestNumber = request.ContestNumber + i
+		contestRequest.ExpectedDraw = drawDate.Format("2006-01-02")
+
+		game := newSavedGame(contestRequest)
+		game.RecurringID = bet.ID
+		if i == 0 {
+			contestCost = gameCost(game)
+		}
+		game.Cost = contestCost
+
+		if err := insertGame(tx, game); err != nil {
+			return nil, err
+		}
+
+		bet.TotalCost += game.Cost
+		bet.Games = append(bet.Games, *game)
+	}
+
+	_, err = tx.Exec(`
+		INSERT INTO recurring_bets (id, lottery_type, first_contest, contests, total_cost, created_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`, bet.ID, bet.LotteryType, bet.FirstContest, bet.Contests, bet.TotalCost, bet.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao salvar teimosinha: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("erro ao confirmar teimosinha: %w", err)
+	}
+
+	logs.LogDatabase("✅ Teimosinha salva: %d jogos, custo total R$ %.2f", len(bet.Games), bet.TotalCost)
+	return bet, nil
+}
+
+// GetRecurringBets busca todas as Teimosinhas com seus jogos
+func (sg *SavedGamesDB) GetRecurringBets() ([]models.RecurringBet, error) {
+	rows, err := sg.db.Query(`
+		SELECT id, lottery_type, first_contest, contests, total_cost, created_at
+		FROM recurring_bets ORDER BY created_at DESC
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar teimosinhas: %w", err)
+	}
+	defer rows.Close()
+
+	var bets []models.RecurringBet
+	for rows.Next() {
+		var bet models.RecurringBet
+		if err := rows.Scan(&bet.ID, &bet.LotteryType, &bet.FirstContest, &bet.Contests, &bet.TotalCost, &bet.CreatedAt); err != nil {
+			return nil, fmt.Errorf("erro ao fazer scan da teimosinha: %w", err)
+		}
+		bets = append(bets, bet)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("erro ao buscar teimosinhas: %w", err)
+	}
+
+	for i := range bets {
+		if err := sg.loadRecurringGames(&bets[i]); err != nil {
+			return nil, err
+		}
+	}
+	return bets, nil
+}
+
+// GetRecurringBet busca uma Teimosinha pelo ID com seus jogos
+func (sg *SavedGamesDB) GetRecurringBet(id string) (*models.RecurringBet, error) {
+	var bet models.RecurringBet
+	err := sg.db.QueryRow(`
+		SELECT id, lottery_type, first_contest, contests, total_cost, created_at
+		FROM recurring_bets WHERE id = ?
+	`, id).Scan(&bet.ID, &bet.LotteryType, &bet.FirstContest, &bet.Contests, &bet.TotalCost, &bet.CreatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("teimosinha não encontrada")
+		}
+		return nil, fmt.Errorf("erro ao buscar teimosinha: %w", err)
+	}
+
+	if err := sg.loadRecurringGames(&bet); err != nil {
+		return nil, err
+	}
+	return &bet, nil
+}
+
+// loadRecurringGames carrega os jogos da Teimosinha em ordem de concurso
+func (sg *SavedGamesDB) loadRecurringGames(bet *models.RecurringBet) error {
+	games, err := sg.GetSavedGames(models.SavedGamesFilter{RecurringID: bet.ID})
+	if err != nil {
+		return fmt.Errorf("erro ao buscar jogos da teimosinha: %w", err)
+	}
+
+	sort.Slice(games, func(i, j int) bool {
+		return games[i].ContestNumber < games[j].ContestNumber
+	})
+	bet.Games = games
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"lottery-optimizer-gui/internal/logs"
+	"lottery-optimizer-gui/internal/lottery"
 	"lottery-optimizer-gui/internal/models"
 
 	"github.com/google/uuid"
@@ -101,6 +102,195 @@ func (sg *SavedGamesDB) createTables() error {
 		return fmt.Errorf("erro ao adicionar coluna draw_date: %w", err)
 	}
 
+	// Conferência por sorteio (JSON) para loterias com mais de um sorteio por concurso
+	if err := sg.addColumnIfNotExists("draw_results", "TEXT DEFAULT NULL"); err != nil {
+		return fmt.Errorf("erro ao adicionar coluna draw_results: %w", err)
+	}
+
+	// Detalhamento do prêmio por faixa (JSON); apostas múltiplas podem ganhar várias faixas
+	if err := sg.addColumnIfNotExists("prize_breakdown", "TEXT DEFAULT NULL"); err != nil {
+		return fmt.Errorf("erro ao adicionar coluna prize_breakdown: %w", err)
+	}
+
+	// Seleção secundária (ex: trevos da +Milionária) e sua conferência
+	if err := sg.addColumnIfNotExists("secondary", "TEXT DEFAULT NULL"); err != nil {
+		return fmt.Errorf("erro ao adicionar coluna secondary: %w", err)
+	}
+
+	if err := sg.addColumnIfNotExists("secondary_matches", "TEXT DEFAULT NULL"); err != nil {
+		return fmt.Errorf("erro ao adicionar coluna secondary_matches: %w", err)
+	}
+
+	if err := sg.addColumnIfNotExists("drawn_secondary", "TEXT DEFAULT NULL"); err != nil {
+		return fmt.Errorf("erro ao adicionar coluna drawn_secondary: %w", err)
+	}
+
+	// Palpite extra (Time do Coração, Mês da Sorte) e sua conferência
+	if err := sg.addColumnIfNotExists("extra_pick", "TEXT DEFAULT NULL"); err != nil {
+		return fmt.Errorf("erro ao adicionar coluna extra_pick: %w", err)
+	}
+
+	if err := sg.addColumnIfNotExists("drawn_extra_pick", "TEXT DEFAULT NULL"); err != nil {
+		return fmt.Errorf("erro ao adicionar coluna drawn_extra_pick: %w", err)
+	}
+
+	if err := sg.addColumnIfNotExists("extra_pick_hit", "INTEGER DEFAULT NULL"); err != nil {
+		return fmt.Errorf("erro ao adicionar coluna extra_pick_hit: %w", err)
+	}
+
+	// Apostas posicionais (ex: Super Sete) e as colunas acertadas
+	if err := sg.addColumnIfNotExists("columns", "TEXT DEFAULT NULL"); err != nil {
+		return fmt.Errorf("erro ao adicionar coluna columns: %w", err)
+	}
+
+	if err := sg.addColumnIfNotExists("column_matches", "TEXT DEFAULT NULL"); err != nil {
+		return fmt.Errorf("erro ao adicionar coluna column_matches: %w", err)
+	}
+
+	// Loteca: palpites por partida e sua conferência
+	if err := sg.addColumnIfNotExists("picks", "TEXT DEFAULT NULL"); err != nil {
+		return fmt.Errorf("erro ao adicionar coluna picks: %w", err)
+	}
+
+	if err := sg.addColumnIfNotExists("drawn_outcomes", "TEXT DEFAULT NULL"); err != nil {
+		return fmt.Errorf("erro ao adicionar coluna drawn_outcomes: %w", err)
+	}
+
+	if err := sg.addColumnIfNotExists("match_hits", "TEXT DEFAULT NULL"); err != nil {
+		return fmt.Errorf("erro ao adicionar coluna match_hits: %w", err)
+	}
+
+	// Teimosinha: jogos da mesma sequência de concursos consecutivos
+	if err := sg.addColumnIfNotExists("recurring_id", "TEXT DEFAULT NULL"); err != nil {
+		return fmt.Errorf("erro ao adicionar coluna recurring_id: %w", err)
+	}
+
+	if err := sg.createRecurringBetsTable(); err != nil {
+		return fmt.Errorf("erro ao criar tabela de teimosinhas: %w", err)
+	}
+
+	// Bolão: jogos agrupados, participantes e extrato
+	if err := sg.addColumnIfNotExists("pool_id", "TEXT DEFAULT NULL"); err != nil {
+		return fmt.Errorf("erro ao adicionar coluna pool_id: %w", err)
+	}
+
+	if err := sg.createPoolTables(); err != nil {
+		return fmt.Errorf("erro ao criar tabelas de bolão: %w", err)
+	}
+
+	// Jogos antigos foram gravados com grafias diferentes (ex: "mega-sena")
+	if err := sg.normalizeLotteryTypes(); err != nil {
+		return fmt.Errorf("erro ao normalizar tipos de loteria: %w", err)
+	}
+
+	// Jogos antigos foram gravados sem custo
+	if err := sg.backfillGameCosts(); err != nil {
+		return fmt.Errorf("erro ao calcular custo dos jogos: %w", err)
+	}
+
+	return nil
+}
+
+// backfillGameCosts calcula o custo dos jogos gravados sem ele, com o preço em vigor na data do concurso
+// Jogos que já têm custo não são relidos; só os que ainda estão sem custo voltam a cada abertura
+func (sg *SavedGamesDB) backfillGameCosts() error {
+	rows, err := sg.db.Query(`SELECT id, lottery_type, numbers, secondary, columns, picks, expected_draw
+		FROM saved_games WHERE cost IS NULL OR cost = 0`)
+	if err != nil {
+		return fmt.Errorf("erro ao listar jogos sem custo: %w", err)
+	}
+
+	var games []models.SavedGame
+	for rows.Next() {
+		var game models.SavedGame
+		if err := rows.Scan(&game.ID, &game.LotteryType, &game.Numbers, &game.Secondary,
+			&game.Columns, &game.Picks, &game.ExpectedDraw); err != nil {
+			rows.Close()
+			return fmt.Errorf("erro ao ler jogo sem custo: %w", err)
+		}
+		games = append(games, game)
+	}
+	rows.Close()
+
+	updated := 0
+	for _, game := range games {
+		cost := gameCost(&game)
+		if cost == 0 {
+			continue
+		}
+		if _, err := sg.db.Exec("UPDATE saved_games SET cost = ? WHERE id = ?", cost, game.ID); err != nil {
+			return fmt.Errorf("erro ao gravar custo do jogo %s: %w", game.ID, err)
+		}
+		updated++
+	}
+
+	if updated > 0 {
+		logs.LogDatabase("✅ Custo calculado para %d jogos antigos", updated)
+	}
+
+	return nil
+}
+
+// gameCost calcula o custo do jogo com o preço em vigor na data do concurso
+// Datas inválidas usam o preço atual
+func gameCost(game *models.SavedGame) float64 {
+	ltype, err := lottery.ParseLotteryType(game.LotteryType)
+	if err != nil {
+		return 0
+	}
+
+	drawDate, _ := time.Parse("2006-01-02", game.ExpectedDraw)
+	cost, err := lottery.GameCostOn(lottery.Game{
+		Type:      ltype,
+		Numbers:   game.Numbers,
+		Secondary: game.Secondary,
+		Columns:   game.Columns,
+		Picks:     game.Picks,
+	}, drawDate)
+	if err != nil {
+		logs.LogDatabase("⚠️ Custo do jogo %s não calculado: %v", game.ID, err)
+		return 0
+	}
+	return cost
+}
+
+// normalizeLotteryTypes converte os tipos de loteria gravados para o identificador canônico
+// Tipos que não correspondem a nenhuma loteria registrada são mantidos como estão
+func (sg *SavedGamesDB) normalizeLotteryTypes() error {
+	rows, err := sg.db.Query("SELECT DISTINCT lottery_type FROM saved_games")
+	if err != nil {
+		return fmt.Errorf("erro ao listar tipos de loteria: %w", err)
+	}
+
+	var storedTypes []string
+	for rows.Next() {
+		var storedType string
+		if err := rows.Scan(&storedType); err != nil {
+			rows.Close()
+			return fmt.Errorf("erro ao ler tipo de loteria: %w", err)
+		}
+		storedTypes = append(storedTypes, storedType)
+	}
+	rows.Close()
+
+	for _, storedType := range storedTypes {
+		canonical, err := lottery.ParseLotteryType(storedType)
+		if err != nil {
+			logs.LogDatabase("⚠️ Tipo de loteria desconhecido mantido: %s", storedType)
+			continue
+		}
+		if string(canonical) == storedType {
+			continue
+		}
+
+		result, err := sg.db.Exec("UPDATE saved_games SET lottery_type = ? WHERE lottery_type = ?", string(canonical), storedType)
+		if err != nil {
+			return fmt.Errorf("erro ao normalizar %s: %w", storedType, err)
+		}
+		affected, _ := result.RowsAffected()
+		logs.LogDatabase("✅ %d jogos migrados de '%s' para '%s'", affected, storedType, canonical)
+	}
+
 	return nil
 }
 
@@ -151,57 +341,108 @@ func (sg *SavedGamesDB) SaveGame(request models.SaveGameRequest) (*models.SavedG
 	logs.LogDatabase("🚀 Iniciando salvamento no banco de dados")
 	logs.LogDatabase("📋 Request: %+v", request)
 
-	game := &models.SavedGame{
+	game := newSavedGame(request)
+	game.Cost = gameCost(game)
+
+	logs.LogDatabase("🎲 Objeto do jogo criado: ID=%s, Tipo=%s, Números=%v", game.ID, game.LotteryType, game.Numbers)
+
+	if err := insertGame(sg.db, game); err != nil {
+		return nil, err
+	}
+
+	logs.LogDatabase("✅ Jogo salvo com sucesso no banco! ID: %s", game.ID)
+
+	return game, nil
+}
+
+// newSavedGame monta um jogo pendente a partir da requisição
+func newSavedGame(request models.SaveGameRequest) *models.SavedGame {
+	return &models.SavedGame{
 		ID:            uuid.New().String(),
 		LotteryType:   request.LotteryType,
 		Numbers:       models.IntSlice(request.Numbers),
+		Secondary:     models.IntSlice(request.Secondary),
+		ExtraPick:     request.ExtraPick,
+		Columns:       models.ColumnSlice(request.Columns),
+		Picks:         models.StringSlice(request.Picks),
 		ExpectedDraw:  request.ExpectedDraw,
 		ContestNumber: request.ContestNumber,
+		PoolID:        request.PoolID,
 		Status:        "pending",
 		CreatedAt:     time.Now(),
 	}
+}
 
-	logs.LogDatabase("🎲 Objeto do jogo criado: ID=%s, Tipo=%s, Números=%v", game.ID, game.LotteryType, game.Numbers)
+// execer é satisfeito por *sql.DB e *sql.Tx
+type execer interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+}
 
+// insertGame grava um jogo salvo, dentro ou fora de uma transação
+func insertGame(db execer, game *models.SavedGame) error {
 	query := `
-		INSERT INTO saved_games (id, lottery_type, numbers, expected_draw, contest_number, status, created_at)
-		VALUES (?, ?, ?, ?, ?, ?, ?)
+		INSERT INTO saved_games (id, lottery_type, numbers, secondary, extra_pick, columns, picks, expected_draw, contest_number, status, created_at, cost, recurring_id, pool_id)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`
 
 	logs.LogDatabase("📝 Executando query: %s", query)
 	logs.LogDatabase("🔧 Parâmetros: ID=%s, Type=%s, Numbers=%v, Date=%s, Contest=%d, Status=%s",
 		game.ID, game.LotteryType, game.Numbers, game.ExpectedDraw, game.ContestNumber, game.Status)
 
-	_, err := sg.db.Exec(query,
+	var recurringID, poolID interface{}
+	if game.RecurringID != "" {
+		recurringID = game.RecurringID
+	}
+	if game.PoolID != "" {
+		poolID = game.PoolID
+	}
+
+	_, err := db.Exec(query,
 		game.ID,
 		game.LotteryType,
 		game.Numbers,
+		secondaryValue(game.Secondary),
+		game.ExtraPick,
+		game.Columns,
+		game.Picks,
 		game.ExpectedDraw,
 		game.ContestNumber,
 		game.Status,
 		game.CreatedAt,
+		game.Cost,
+		recurringID,
+		poolID,
 	)
 
 	if err != nil {
 		logs.LogError(logs.CategoryDatabase, "❌ Erro no Exec da query: %v", err)
-		return nil, fmt.Errorf("erro ao salvar jogo: %w", err)
+		return fmt.Errorf("erro ao salvar jogo: %w", err)
 	}
 
-	logs.LogDatabase("✅ Jogo salvo com sucesso no banco! ID: %s", game.ID)
+	return nil
+}
 
-	return game, nil
+// secondaryValue grava NULL quando o jogo não tem seleção secundária
+func secondaryValue(secondary models.IntSlice) interface{} {
+	if len(secondary) == 0 {
+		return nil
+	}
+	return secondary
 }
 
 // GetSavedGames busca jogos salvos com filtros opcionais
 func (sg *SavedGamesDB) GetSavedGames(filter models.SavedGamesFilter) ([]models.SavedGame, error) {
-	query := `SELECT id, lottery_type, numbers, expected_draw, contest_number, status, created_at, checked_at,
-					 hit_count, matches, drawn_numbers, prize_description, prize_amount, is_winner, contest_number_actual, draw_date
-			  FROM saved_games WHERE 1=1`
+	query := "SELECT " + savedGameColumns + " FROM saved_games WHERE 1=1"
 	args := []interface{}{}
 
 	if filter.LotteryType != "" {
+		// O filtro aceita grafias legadas; no banco os tipos estão no formato canônico
+		lotteryType := filter.LotteryType
+		if canonical, err := lottery.ParseLotteryType(lotteryType); err == nil {
+			lotteryType = string(canonical)
+		}
 		query += " AND lottery_type = ?"
-		args = append(args, filter.LotteryType)
+		args = append(args, lotteryType)
 	}
 
 	if filter.Status != "" {
@@ -219,6 +460,16 @@ func (sg *SavedGamesDB) GetSavedGames(filter models.SavedGamesFilter) ([]models.
 		args = append(args, filter.ToDate)
 	}
 
+	if filter.RecurringID != "" {
+		query += " AND recurring_id = ?"
+		args = append(args, filter.RecurringID)
+	}
+
+	if filter.PoolID != "" {
+		query += " AND pool_id = ?"
+		args = append(args, filter.PoolID)
+	}
+
 	query += " ORDER BY created_at DESC"
 
 	rows, err := sg.db.Query(query, args...)
@@ -229,94 +480,14 @@ func (sg *SavedGamesDB) GetSavedGames(filter models.SavedGamesFilter) ([]models.
 
 	var games []models.SavedGame
 	for rows.Next() {
-		var game models.SavedGame
-		var checkedAt sql.NullTime
-
-		// Campos do resultado
-		var hitCount sql.NullInt64
-		var matchesJSON sql.NullString
-		var drawnNumbersJSON sql.NullString
-		var prizeDescription sql.NullString
-		var prizeAmount sql.NullFloat64
-		var isWinner sql.NullInt64
-		var contestNumberActual sql.NullInt64
-		var drawDate sql.NullString
-
-		err := rows.Scan(
-			&game.ID,
-			&game.LotteryType,
-			&game.Numbers,
-			&game.ExpectedDraw,
-			&game.ContestNumber,
-			&game.Status,
-			&game.CreatedAt,
-			&checkedAt,
-			&hitCount,
-			&matchesJSON,
-			&drawnNumbersJSON,
-			&prizeDescription,
-			&prizeAmount,
-			&isWinner,
-			&contestNumberActual,
-			&drawDate,
-		)
+		game, err := scanSavedGame(rows)
 		if err != nil {
 			return nil, fmt.Errorf("erro ao fazer scan do jogo: %w", err)
 		}
-
-		if checkedAt.Valid {
-			game.CheckedAt = &checkedAt.Time
-		}
-
-		// Se o jogo foi verificado e tem dados de resultado, carregar o resultado
-		if game.Status == "checked" && hitCount.Valid {
-			result := &models.GameResult{
-				HitCount: int(hitCount.Int64),
-			}
-
-			// Deserializar matches
-			if matchesJSON.Valid && matchesJSON.String != "" {
-				var matches []int
-				if err := json.Unmarshal([]byte(matchesJSON.String), &matches); err == nil {
-					result.Matches = matches
-				}
-			}
-
-			// Deserializar drawn numbers
-			if drawnNumbersJSON.Valid && drawnNumbersJSON.String != "" {
-				var drawnNumbers []int
-				if err := json.Unmarshal([]byte(drawnNumbersJSON.String), &drawnNumbers); err == nil {
-					result.DrawnNumbers = drawnNumbers
-				}
-			}
-
-			if prizeDescription.Valid {
-				result.Prize = prizeDescription.String
-			}
-
-			if prizeAmount.Valid {
-				result.PrizeAmount = prizeAmount.Float64
-			}
-
-			if isWinner.Valid {
-				result.IsWinner = isWinner.Int64 == 1
-			}
-
-			if contestNumberActual.Valid {
-				result.ContestNumber = int(contestNumberActual.Int64)
-			}
-
-			if drawDate.Valid {
-				result.DrawDate = drawDate.String
-			}
-
-			game.Result = result
-		}
-
 		games = append(games, game)
 	}
 
-	return games, nil
+	return games, rows.Err()
 }
 
 // GetPendingGames busca jogos que ainda não foram verificados
@@ -335,6 +506,58 @@ func (sg *SavedGamesDB) UpdateGameStatus(gameID string, status string) error {
 	return nil
 }
 
+// ReopenCheckedGames volta para pendente os jogos já conferidos de um concurso
+// Usado quando o resultado oficial corrige um resultado manual: os jogos são conferidos de novo
+// Os créditos de prêmio de bolão desses jogos são removidos, para a nova conferência dividir o valor correto
+func (sg *SavedGamesDB) ReopenCheckedGames(ltype lottery.LotteryType, contest int) (int, error) {
+	rows, err := sg.db.Query("SELECT id, lottery_type FROM saved_games WHERE contest_number = ? AND status = 'checked'", contest)
+	if err != nil {
+		return 0, fmt.Errorf("erro ao buscar jogos conferidos do concurso %d: %w", contest, err)
+	}
+
+	// O tipo é comparado já normalizado, pois jogos antigos podem ter grafias legadas
+	var ids []string
+	for rows.Next() {
+		var id, gameType string
+		if err := rows.Scan(&id, &gameType); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("erro ao fazer scan do jogo: %w", err)
+		}
+		if parsed, err := lottery.ParseLotteryType(gameType); err == nil && parsed == ltype {
+			ids = append(ids, id)
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("erro ao buscar jogos conferidos do concurso %d: %w", contest, err)
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	tx, err := sg.db.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("erro ao iniciar transação: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, id := range ids {
+		if _, err := tx.Exec("UPDATE saved_games SET status = 'pending', checked_at = NULL WHERE id = ?", id); err != nil {
+			return 0, fmt.Errorf("erro ao reabrir jogo %s: %w", id, err)
+		}
+		if _, err := tx.Exec("DELETE FROM pool_ledger WHERE game_id = ? AND kind = ?", id, models.LedgerPrize); err != nil {
+			return 0, fmt.Errorf("erro ao remover prêmio do bolão do jogo %s: %w", id, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("erro ao confirmar reabertura dos jogos: %w", err)
+	}
+
+	logs.LogDatabase("🔄 %d jogos de %s concurso %d voltaram para conferência", len(ids), ltype, contest)
+	return len(ids), nil
+}
+
 // UpdateGameResult atualiza o resultado de um jogo verificado
 func (sg *SavedGamesDB) UpdateGameResult(gameID string, result *models.GameResult) error {
 	logs.LogDatabase("🎯 Atualizando resultado do jogo %s", gameID)
@@ -351,6 +574,84 @@ func (sg *SavedGamesDB) UpdateGameResult(gameID string, result *models.GameResul
 		return fmt.Errorf("erro ao serializar drawn_numbers: %w", err)
 	}
 
+	var drawResultsJSON interface{}
+	if len(result.DrawResults) > 0 {
+		data, err := json.Marshal(result.DrawResults)
+		if err != nil {
+			return fmt.Errorf("erro ao serializar draw_results: %w", err)
+		}
+		drawResultsJSON = string(data)
+	}
+
+	var secondaryMatchesJSON, drawnSecondaryJSON interface{}
+	if len(result.DrawnSecondary) > 0 {
+		data, err := json.Marshal(result.SecondaryMatches)
+		if err != nil {
+			return fmt.Errorf("erro ao serializar secondary_matches: %w", err)
+		}
+		secondaryMatchesJSON = string(data)
+
+		data, err = json.Marshal(result.DrawnSecondary)
+		if err != nil {
+			return fmt.Errorf("erro ao serializar drawn_secondary: %w", err)
+		}
+		drawnSecondaryJSON = string(data)
+	}
+
+	var drawnExtraPick, extraPickHit interface{}
+	if result.DrawnExtraPick != "" {
+		drawnExtraPick = result.DrawnExtraPick
+		extraPickHit = 0
+		if result.ExtraPickHit {
+			extraPickHit = 1
+		}
+	}
+
+	var columnMatchesJSON interface{}
+	if result.ColumnMatches != nil {
+		data, err := json.Marshal(result.ColumnMatches)
+		if err != nil {
+			return fmt.Errorf("erro ao serializar column_matches: %w", err)
+		}
+		columnMatchesJSON = string(data)
+	}
+
+	var drawnOutcomesJSON, matchHitsJSON interface{}
+	if len(result.DrawnOutcomes) > 0 {
+		data, err := json.Marshal(result.DrawnOutcomes)
+		if err != nil {
+			return fmt.Errorf("erro ao serializar drawn_outcomes: %w", err)
+		}
+		drawnOutcomesJSON = string(data)
+
+		data, err = json.Marshal(result.MatchHits)
+		if err != nil {
+			return fmt.Errorf("erro ao serializar match_hits: %w", err)
+		}
+		matchHitsJSON = string(data)
+	}
+
+	var prizeBreakdownJSON interface{}
+	if len(result.PrizeBreakdown) > 0 {
+		data, err := json.Marshal(result.PrizeBreakdown)
+		if err != nil {
+			return fmt.Errorf("erro ao serializar prize_breakdown: %w", err)
+		}
+		prizeBreakdownJSON = string(data)
+	}
+
+	// Jogos de bolão têm o prêmio dividido na mesma transação: resultado e extrato nunca divergem
+	var poolID sql.NullString
+	if err := sg.db.QueryRow("SELECT pool_id FROM saved_games WHERE id = ?", gameID).Scan(&poolID); err != nil {
+		return fmt.Errorf("erro ao buscar jogo %s: %w", gameID, err)
+	}
+	var participants []models.PoolParticipant
+	if poolID.String != "" {
+		if participants, err = sg.getPoolParticipants(poolID.String); err != nil {
+			return err
+		}
+	}
+
 	query := `
 		UPDATE saved_games 
 		SET status = 'checked', 
@@ -362,7 +663,17 @@ func (sg *SavedGamesDB) UpdateGameResult(gameID string, result *models.GameResul
 			prize_amount = ?,
 			is_winner = ?,
 			contest_number_actual = ?,
-			draw_date = ?
+			draw_date = ?,
+			draw_results = ?,
+			secondary_matches = ?,
+			drawn_secondary = ?,
+			drawn_extra_pick = ?,
+			extra_pick_hit = ?,
+			column_matches = ?,
+			drawn_outcomes = ?,
+			match_hits = ?,
+			prize_breakdown = ?,
+			prize = ?
 		WHERE id = ?
 	`
 
@@ -371,7 +682,13 @@ func (sg *SavedGamesDB) UpdateGameResult(gameID string, result *models.GameResul
 		isWinnerInt = 1
 	}
 
-	_, err = sg.db.Exec(query,
+	tx, err := sg.db.Begin()
+	if err != nil {
+		return fmt.Errorf("erro ao iniciar transação: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec(query,
 		time.Now(),
 		result.HitCount,
 		string(matchesJSON),
@@ -381,6 +698,16 @@ func (sg *SavedGamesDB) UpdateGameResult(gameID string, result *models.GameResul
 		isWinnerInt,
 		result.ContestNumber,
 		result.DrawDate,
+		drawResultsJSON,
+		secondaryMatchesJSON,
+		drawnSecondaryJSON,
+		drawnExtraPick,
+		extraPickHit,
+		columnMatchesJSON,
+		drawnOutcomesJSON,
+		matchHitsJSON,
+		prizeBreakdownJSON,
+		result.PrizeAmount,
 		gameID,
 	)
 
@@ -389,6 +716,20 @@ func (sg *SavedGamesDB) UpdateGameResult(gameID string, result *models.GameResul
 		return fmt.Errorf("erro ao atualizar resultado do jogo: %w", err)
 	}
 
+	if poolID.String != "" {
+		prize := 0.0
+		if result.IsWinner {
+			prize = result.PrizeAmount
+		}
+		if err := creditPoolPrize(tx, poolID.String, gameID, prize, participants); err != nil {
+			return fmt.Errorf("erro ao dividir prêmio no bolão %s: %w", poolID.String, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("erro ao confirmar resultado do jogo: %w", err)
+	}
+
 	logs.LogDatabase("✅ Resultado atualizado com sucesso!")
 	return nil
 }
@@ -405,10 +746,31 @@ func (sg *SavedGamesDB) DeleteGame(gameID string) error {
 
 // GetGameByID busca um jogo específico pelo ID
 func (sg *SavedGamesDB) GetGameByID(gameID string) (*models.SavedGame, error) {
-	query := `SELECT id, lottery_type, numbers, expected_draw, contest_number, status, created_at, checked_at,
-					 hit_count, matches, drawn_numbers, prize_description, prize_amount, is_winner, contest_number_actual, draw_date
-			  FROM saved_games WHERE id = ?`
+	game, err := scanSavedGame(sg.db.QueryRow("SELECT "+savedGameColumns+" FROM saved_games WHERE id = ?", gameID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("jogo não encontrado")
+		}
+		return nil, fmt.Errorf("erro ao buscar jogo: %w", err)
+	}
 
+	return &game, nil
+}
+
+// scanner é uma linha de consulta (sql.Row ou sql.Rows)
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// savedGameColumns são as colunas lidas por scanSavedGame, na ordem do Scan
+const savedGameColumns = `id, lottery_type, numbers, expected_draw, contest_number, status, created_at, checked_at,
+	hit_count, matches, drawn_numbers, prize_description, prize_amount, is_winner, contest_number_actual, draw_date,
+	cost, prize, draw_results, secondary, secondary_matches, drawn_secondary,
+	extra_pick, drawn_extra_pick, extra_pick_hit, columns, column_matches,
+	picks, drawn_outcomes, match_hits, prize_breakdown, recurring_id, pool_id`
+
+// scanSavedGame lê um jogo salvo com seu resultado, se já tiver sido conferido
+func scanSavedGame(row scanner) (models.SavedGame, error) {
 	var game models.SavedGame
 	var checkedAt sql.NullTime
 
@@ -421,8 +783,19 @@ func (sg *SavedGamesDB) GetGameByID(gameID string) (*models.SavedGame, error) {
 	var isWinner sql.NullInt64
 	var contestNumberActual sql.NullInt64
 	var drawDate sql.NullString
-
-	err := sg.db.QueryRow(query, gameID).Scan(
+	var drawResultsJSON sql.NullString
+	var secondaryMatchesJSON sql.NullString
+	var drawnSecondaryJSON sql.NullString
+	var drawnExtraPick sql.NullString
+	var extraPickHit sql.NullInt64
+	var columnMatchesJSON sql.NullString
+	var drawnOutcomesJSON sql.NullString
+	var matchHitsJSON sql.NullString
+	var prizeBreakdownJSON sql.NullString
+	var recurringID sql.NullString
+	var poolID sql.NullString
+
+	if err := row.Scan(
 		&game.ID,
 		&game.LotteryType,
 		&game.Numbers,
@@ -439,19 +812,39 @@ func (sg *SavedGamesDB) GetGameByID(gameID string) (*models.SavedGame, error) {
 		&isWinner,
 		&contestNumberActual,
 		&drawDate,
-	)
-
-	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("jogo não encontrado")
-		}
-		return nil, fmt.Errorf("erro ao buscar jogo: %w", err)
+		&game.Cost,
+		&game.Prize,
+		&drawResultsJSON,
+		&game.Secondary,
+		&secondaryMatchesJSON,
+		&drawnSecondaryJSON,
+		&game.ExtraPick,
+		&drawnExtraPick,
+		&extraPickHit,
+		&game.Columns,
+		&columnMatchesJSON,
+		&game.Picks,
+		&drawnOutcomesJSON,
+		&matchHitsJSON,
+		&prizeBreakdownJSON,
+		&recurringID,
+		&poolID,
+	); err != nil {
+		return game, err
 	}
 
 	if checkedAt.Valid {
 		game.CheckedAt = &checkedAt.Time
 	}
 
+	if recurringID.Valid {
+		game.RecurringID = recurringID.String
+	}
+
+	if poolID.Valid {
+		game.PoolID = poolID.String
+	}
+
 	// Se o jogo foi verificado e tem dados de resultado, carregar o resultado
 	if game.Status == "checked" && hitCount.Valid {
 		result := &models.GameResult{
@@ -494,10 +887,78 @@ func (sg *SavedGamesDB) GetGameByID(gameID string) (*models.SavedGame, error) {
 			result.DrawDate = drawDate.String
 		}
 
+		// Deserializar conferência por sorteio
+		if drawResultsJSON.Valid && drawResultsJSON.String != "" {
+			var drawResults []models.DrawResult
+			if err := json.Unmarshal([]byte(drawResultsJSON.String), &drawResults); err == nil {
+				result.DrawResults = drawResults
+			}
+		}
+
+		// Deserializar conferência da seleção secundária
+		if secondaryMatchesJSON.Valid && secondaryMatchesJSON.String != "" {
+			var secondaryMatches []int
+			if err := json.Unmarshal([]byte(secondaryMatchesJSON.String), &secondaryMatches); err == nil {
+				result.SecondaryMatches = secondaryMatches
+				result.SecondaryHitCount = len(secondaryMatches)
+			}
+		}
+
+		if drawnSecondaryJSON.Valid && drawnSecondaryJSON.String != "" {
+			var drawnSecondary []int
+			if err := json.Unmarshal([]byte(drawnSecondaryJSON.String), &drawnSecondary); err == nil {
+				result.DrawnSecondary = drawnSecondary
+			}
+		}
+
+		if drawnExtraPick.Valid {
+			result.DrawnExtraPick = drawnExtraPick.String
+		}
+
+		if extraPickHit.Valid {
+			result.ExtraPickHit = extraPickHit.Int64 == 1
+		}
+
+		// Deserializar colunas acertadas (apostas posicionais)
+		if columnMatchesJSON.Valid && columnMatchesJSON.String != "" {
+			var columnMatches []int
+			if err := json.Unmarshal([]byte(columnMatchesJSON.String), &columnMatches); err == nil {
+				result.ColumnMatches = columnMatches
+			}
+		}
+
+		// Deserializar conferência da Loteca
+		if drawnOutcomesJSON.Valid && drawnOutcomesJSON.String != "" {
+			var drawnOutcomes []string
+			if err := json.Unmarshal([]byte(drawnOutcomesJSON.String), &drawnOutcomes); err == nil {
+				result.DrawnOutcomes = drawnOutcomes
+			}
+		}
+
+		if matchHitsJSON.Valid && matchHitsJSON.String != "" {
+			var matchHits []int
+			if err := json.Unmarshal([]byte(matchHitsJSON.String), &matchHits); err == nil {
+				result.MatchHits = matchHits
+			}
+		}
+
+		// Deserializar detalhamento do prêmio por faixa
+		if prizeBreakdownJSON.Valid && prizeBreakdownJSON.String != "" {
+			var prizeBreakdown []models.PrizeTier
+			if err := json.Unmarshal([]byte(prizeBreakdownJSON.String), &prizeBreakdown); err == nil {
+				result.PrizeBreakdown = prizeBreakdown
+			}
+		}
+
+		// Jogos verificados antes da coluna prize ser preenchida
+		if game.Prize == 0 && result.IsWinner {
+			game.Prize = result.PrizeAmount
+		}
+
 		game.Result = result
 	}
 
-	return &game, nil
+	return game, nil
 }
 
 // GetAllSavedGames busca todos os jogos salvos (para analytics)
@@ -0,0 +1,100 @@
+package database
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"lottery-optimizer-gui/internal/lottery"
+	"lottery-optimizer-gui/internal/models"
+)
+
+func TestBackfillGameCosts(t *testing.T) {
+	db := newTestDB(t)
+
+	save := func(request models.SaveGameRequest, cost float64) string {
+		t.Helper()
+		game, err := db.SaveGame(request)
+		if err != nil {
+			t.Fatalf("SaveGame: %v", err)
+		}
+		// Simula um jogo gravado antes da coluna de custo
+		if _, err := db.db.Exec("UPDATE saved_games SET cost = ? WHERE id = ?", cost, game.ID); err != nil {
+			t.Fatalf("UPDATE cost: %v", err)
+		}
+		return game.ID
+	}
+
+	mega := models.SaveGameRequest{LotteryType: string(lottery.MegaSena), Numbers: []int{1, 2, 3, 4, 5, 6, 7}, ExpectedDraw: "2026-01-03"}
+	withoutCost := save(mega, 0)
+	withCost := save(mega, 99)
+	unknown := save(models.SaveGameRequest{LotteryType: "loteria-extinta", Numbers: []int{1, 2, 3}, ExpectedDraw: "2026-01-03"}, 0)
+
+	if err := db.backfillGameCosts(); err != nil {
+		t.Fatalf("backfillGameCosts: %v", err)
+	}
+
+	want, err := lottery.GameCostOn(lottery.Game{Type: lottery.MegaSena, Numbers: mega.Numbers}, time.Date(2026, time.January, 3, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("GameCostOn: %v", err)
+	}
+	for id, wantCost := range map[string]float64{withoutCost: want, withCost: 99, unknown: 0} {
+		var cost float64
+		if err := db.db.QueryRow("SELECT cost FROM saved_games WHERE id = ?", id).Scan(&cost); err != nil {
+			t.Fatalf("SELECT cost: %v", err)
+		}
+		if cost != wantCost {
+			t.Errorf("jogo %s: cost = %.2f, want %.2f", id, cost, wantCost)
+		}
+	}
+}
+
+func TestGetGameByIDMatchesList(t *testing.T) {
+	db := newTestDB(t)
+
+	game, err := db.SaveGame(models.SaveGameRequest{
+		LotteryType:  string(lottery.MaisMilionaria),
+		Numbers:      []int{1, 2, 3, 4, 5, 6},
+		Secondary:    []int{1, 2},
+		ExpectedDraw: "2026-01-03",
+	})
+	if err != nil {
+		t.Fatalf("SaveGame: %v", err)
+	}
+	result := &models.GameResult{
+		ContestNumber:    250,
+		DrawDate:         "03/01/2026",
+		DrawnNumbers:     []int{1, 2, 3, 4, 10, 20},
+		Matches:          []int{1, 2, 3, 4},
+		HitCount:         4,
+		DrawnSecondary:   []int{2, 5},
+		SecondaryMatches: []int{2},
+		Prize:            "4 acertos + 1 trevo",
+		PrizeAmount:      120.5,
+		IsWinner:         true,
+	}
+	if err := db.UpdateGameResult(game.ID, result); err != nil {
+		t.Fatalf("UpdateGameResult: %v", err)
+	}
+
+	byID, err := db.GetGameByID(game.ID)
+	if err != nil {
+		t.Fatalf("GetGameByID: %v", err)
+	}
+	listed, err := db.GetSavedGames(models.SavedGamesFilter{})
+	if err != nil {
+		t.Fatalf("GetSavedGames: %v", err)
+	}
+	if len(listed) != 1 || !reflect.DeepEqual(listed[0], *byID) {
+		t.Fatalf("GetSavedGames() = %+v, want [%+v]", listed, *byID)
+	}
+
+	result.SecondaryHitCount = 1
+	if byID.Status != "checked" || byID.Prize != 120.5 || !reflect.DeepEqual(byID.Result, result) {
+		t.Errorf("GetGameByID().Result = %+v, want %+v", byID.Result, result)
+	}
+
+	if _, err := db.GetGameByID("inexistente"); err == nil {
+		t.Error("GetGameByID(inexistente) sem erro")
+	}
+}
@@ -0,0 +1,272 @@
+package lottery
+
+import (
+	"sync"
+	"time"
+)
+
+// DrawHour hora dos sorteios da CAIXA (horário de Brasília)
+const DrawHour = 20
+
+// maxProjectionDays limita a busca por dias de sorteio (um calendário sem nenhum dia válido não trava)
+const maxProjectionDays = 3 * 366
+
+// SaoPaulo fuso dos sorteios da CAIXA
+// Sem a base de fusos do sistema (comum no Windows) usa UTC-3, que vale desde o fim do horário de verão em 2019
+var SaoPaulo = loadSaoPaulo()
+
+func loadSaoPaulo() *time.Location {
+	if loc, err := time.LoadLocation("America/Sao_Paulo"); err == nil {
+		return loc
+	}
+	return time.FixedZone("BRT", -3*60*60)
+}
+
+// SpecialDraw é um concurso especial em uma data do ano (ex: Mega da Virada em 31/12)
+// Só um concurso OffSchedule é sorteado fora dos dias regulares da loteria ou em feriado; os demais
+// apenas dão nome ao sorteio regular do dia. Datas que mudam de um ano para outro (Lotofácil da
+// Independência, Quina de São João) não têm padrão e são informadas com SetCalendarOverrides
+type SpecialDraw struct {
+	Name        string
+	Month       time.Month
+	Day         int
+	OffSchedule bool
+}
+
+// ScheduledContest é um concurso projetado pelo calendário
+type ScheduledContest struct {
+	Number  int       `json:"number"`
+	Date    time.Time `json:"date"`              // Dia e hora do sorteio em America/Sao_Paulo
+	Special string    `json:"special,omitempty"` // Nome do concurso especial, se for um
+}
+
+// NoDrawDay é uma data sem sorteios regulares
+type NoDrawDay struct {
+	Date time.Time
+	Name string
+}
+
+// SpecialDrawDate é a data de um concurso especial em um ano específico
+// Substitui a data padrão da loteria naquele ano (ex: Quina de São João remarcada para o sábado)
+type SpecialDrawDate struct {
+	Lottery LotteryType
+	Name    string
+	Date    time.Time
+}
+
+// Datas informadas pelo usuário que substituem o calendário padrão, por ano
+var (
+	calendarMu           sync.RWMutex
+	noDrawDayOverrides   map[int][]NoDrawDay
+	specialDrawOverrides map[LotteryType]map[int][]SpecialDraw
+)
+
+// SetCalendarOverrides troca as datas do calendário padrão pelas informadas, ano a ano
+// Um ano com dias sem sorteio informados usa só essa lista; uma loteria com concursos especiais
+// informados em um ano usa só esses concursos naquele ano. Os demais anos seguem a regra padrão
+// Um concurso especial informado só é sorteado fora dos dias regulares se o padrão de mesmo nome for
+func SetCalendarOverrides(noDrawDays []NoDrawDay, specialDraws []SpecialDrawDate) {
+	byYear := make(map[int][]NoDrawDay)
+	for _, holiday := range noDrawDays {
+		day := dayOf(holiday.Date)
+		byYear[day.Year()] = append(byYear[day.Year()], NoDrawDay{Date: day, Name: holiday.Name})
+	}
+
+	byLottery := make(map[LotteryType]map[int][]SpecialDraw)
+	for _, special := range specialDraws {
+		day := dayOf(special.Date)
+		if byLottery[special.Lottery] == nil {
+			byLottery[special.Lottery] = make(map[int][]SpecialDraw)
+		}
+		byLottery[special.Lottery][day.Year()] = append(byLottery[special.Lottery][day.Year()], SpecialDraw{
+			Name:        special.Name,
+			Month:       day.Month(),
+			Day:         day.Day(),
+			OffSchedule: offSchedule(special.Lottery, special.Name),
+		})
+	}
+
+	calendarMu.Lock()
+	defer calendarMu.Unlock()
+	noDrawDayOverrides = byYear
+	specialDrawOverrides = byLottery
+}
+
+// NoDrawDays retorna as datas do ano em que a CAIXA não faz sorteios regulares
+// Só os concursos especiais marcados para a data são sorteados (ex: Mega da Virada em 31/12)
+func NoDrawDays(year int) []NoDrawDay {
+	calendarMu.RLock()
+	override, ok := noDrawDayOverrides[year]
+	calendarMu.RUnlock()
+	if ok {
+		return override
+	}
+
+	easter := easterSunday(year)
+	return []NoDrawDay{
+		{Date: calendarDay(year, time.January, 1), Name: "Confraternização Universal"},
+		{Date: easter.AddDate(0, 0, -48), Name: "Carnaval"},
+		{Date: easter.AddDate(0, 0, -47), Name: "Carnaval"},
+		{Date: easter.AddDate(0, 0, -2), Name: "Sexta-feira Santa"},
+		{Date: calendarDay(year, time.December, 24), Name: "Véspera de Natal"},
+		{Date: calendarDay(year, time.December, 25), Name: "Natal"},
+		{Date: calendarDay(year, time.December, 31), Name: "Véspera de Ano-Novo"},
+	}
+}
+
+// SpecialDraws retorna os concursos especiais da loteria no ano
+func SpecialDraws(ltype LotteryType, year int) []SpecialDraw {
+	calendarMu.RLock()
+	override, ok := specialDrawOverrides[ltype][year]
+	calendarMu.RUnlock()
+	if ok {
+		return override
+	}
+	return GetRules(ltype).SpecialDraws
+}
+
+// offSchedule indica se o concurso especial padrão da loteria com esse nome é sorteado fora dos dias regulares
+func offSchedule(ltype LotteryType, name string) bool {
+	for _, special := range GetRules(ltype).SpecialDraws {
+		if special.Name == name {
+			return special.OffSchedule
+		}
+	}
+	return false
+}
+
+// IsNoDrawDay indica se a data não tem sorteios regulares, e o motivo
+func IsNoDrawDay(date time.Time) (string, bool) {
+	day := dayOf(date)
+	for _, holiday := range NoDrawDays(day.Year()) {
+		if holiday.Date.Equal(day) {
+			return holiday.Name, true
+		}
+	}
+	return "", false
+}
+
+// DrawOn indica se a loteria tem sorteio na data e, se for um concurso especial, o nome dele
+// Um concurso especial que não é OffSchedule não cria sorteio em dia sem sorteio regular
+func DrawOn(ltype LotteryType, date time.Time) (bool, string) {
+	day := dayOf(date)
+	rules := GetRules(ltype)
+
+	var specialName string
+	for _, special := range SpecialDraws(ltype, day.Year()) {
+		if day.Month() == special.Month && day.Day() == special.Day {
+			if special.OffSchedule {
+				return true, special.Name
+			}
+			specialName = special.Name
+		}
+	}
+	if _, holiday := IsNoDrawDay(day); holiday {
+		return false, ""
+	}
+
+	// Sem dias cadastrados, considera um sorteio por semana no sábado
+	drawDays := rules.DrawDays
+	if len(drawDays) == 0 {
+		drawDays = []time.Weekday{time.Saturday}
+	}
+	for _, weekday := range drawDays {
+		if day.Weekday() == weekday {
+			return true, specialName
+		}
+	}
+	return false, ""
+}
+
+// ProjectContests projeta os count concursos seguintes a um concurso conhecido (number, sorteado em date)
+// Cada dia de sorteio do calendário é um concurso, por isso os números seguem em sequência
+func ProjectContests(ltype LotteryType, number int, date time.Time, count int) []ScheduledContest {
+	if count <= 0 {
+		return nil
+	}
+
+	contests := make([]ScheduledContest, 0, count)
+	day := dayOf(date)
+	for i := 0; i < maxProjectionDays && len(contests) < count; i++ {
+		day = day.AddDate(0, 0, 1)
+		if ok, special := DrawOn(ltype, day); ok {
+			number++
+			contests = append(contests, ScheduledContest{
+				Number:  number,
+				Date:    day.Add(DrawHour * time.Hour),
+				Special: special,
+			})
+		}
+	}
+	return contests
+}
+
+// UpcomingContests retorna os count próximos concursos ainda não sorteados em now
+// O ponto de partida é o último sorteio conhecido: se o próximo concurso informado pela CAIXA
+// ainda não passou, ele é usado; senão o calendário avança a partir do próprio sorteio, o que
+// mantém a numeração correta mesmo com os dados locais desatualizados e sem acesso à rede
+func UpcomingContests(ltype LotteryType, latest Draw, now time.Time, count int) []ScheduledContest {
+	if count <= 0 || latest.Number <= 0 {
+		return nil
+	}
+
+	var upcoming []ScheduledContest
+	number, date := latest.Number, latest.Date.Time()
+
+	// A data informada pela CAIXA prevalece, mesmo que o sorteio tenha sido remarcado fora do calendário
+	next := latest.NextDrawDate.Time()
+	if latest.NextDrawNumber == latest.Number+1 && !next.IsZero() {
+		nextDate := dayOf(next).Add(DrawHour * time.Hour)
+		if nextDate.After(now) {
+			_, special := DrawOn(ltype, next)
+			upcoming = append(upcoming, ScheduledContest{Number: latest.NextDrawNumber, Date: nextDate, Special: special})
+			number, date = latest.NextDrawNumber, next
+		}
+	}
+
+	for len(upcoming) < count {
+		batch := ProjectContests(ltype, number, date, count)
+		if len(batch) == 0 {
+			break
+		}
+		for _, contest := range batch {
+			if contest.Date.After(now) && len(upcoming) < count {
+				upcoming = append(upcoming, contest)
+			}
+		}
+		last := batch[len(batch)-1]
+		number, date = last.Number, last.Date
+	}
+	return upcoming
+}
+
+// calendarDay retorna a meia-noite da data em America/Sao_Paulo
+func calendarDay(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, SaoPaulo)
+}
+
+// dayOf retorna o dia do calendário de uma data
+// Datas à meia-noite (ex: as da API da CAIXA, lidas em UTC) valem pelo dia escrito; horários
+// completos são convertidos para America/Sao_Paulo antes de tomar o dia
+func dayOf(t time.Time) time.Time {
+	if t.Hour() != 0 || t.Minute() != 0 || t.Second() != 0 || t.Nanosecond() != 0 {
+		t = t.In(SaoPaulo)
+	}
+	return calendarDay(t.Year(), t.Month(), t.Day())
+}
+
+// easterSunday calcula o domingo de Páscoa do ano (algoritmo de Meeus/Jones/Butcher)
+func easterSunday(year int) time.Time {
+	a := year % 19
+	b, c := year/100, year%100
+	d, e := b/4, b%4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i, k := c/4, c%4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return calendarDay(year, time.Month(month), day)
+}
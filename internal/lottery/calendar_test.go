@@ -0,0 +1,221 @@
+package lottery
+
+import (
+	"testing"
+	"time"
+)
+
+// contest é o concurso esperado: número, dia (AAAA-MM-DD) e concurso especial
+type contest struct {
+	number  int
+	day     string
+	special string
+}
+
+// apiDate monta uma data como a API da CAIXA entrega: meia-noite em UTC
+func apiDate(day string) BrazilianDate {
+	t, err := time.Parse("2006-01-02", day)
+	if err != nil {
+		panic(err)
+	}
+	return BrazilianDate(t)
+}
+
+// saoPauloTime monta um instante no horário de Brasília
+func saoPauloTime(value string) time.Time {
+	t, err := time.ParseInLocation("2006-01-02 15:04", value, SaoPaulo)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func assertContests(t *testing.T, got []ScheduledContest, want []contest) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("got %d concursos %+v, want %d", len(got), got, len(want))
+	}
+	for i, c := range got {
+		day := c.Date.In(SaoPaulo).Format("2006-01-02")
+		if c.Number != want[i].number || day != want[i].day || c.Special != want[i].special {
+			t.Errorf("concurso %d = {%d %s %q}, want {%d %s %q}",
+				i, c.Number, day, c.Special, want[i].number, want[i].day, want[i].special)
+		}
+		if hour := c.Date.In(SaoPaulo).Hour(); hour != DrawHour {
+			t.Errorf("concurso %d sorteado às %dh, want %dh", c.Number, hour, DrawHour)
+		}
+	}
+}
+
+func TestProjectContests(t *testing.T) {
+	tests := []struct {
+		name   string
+		ltype  LotteryType
+		number int
+		date   string
+		count  int
+		want   []contest
+	}{
+		{
+			name: "Sexta-feira Santa sem sorteio", ltype: Quina, number: 6700, date: "2025-04-17", count: 2,
+			want: []contest{{6701, "2025-04-19", ""}, {6702, "2025-04-21", ""}},
+		},
+		{
+			name: "Lotofácil pula a Sexta-feira Santa e o fim de semana", ltype: Lotofacil, number: 3370, date: "2025-04-17", count: 2,
+			want: []contest{{3371, "2025-04-21", ""}, {3372, "2025-04-22", ""}},
+		},
+		{
+			name: "segunda e terça de Carnaval", ltype: Lotofacil, number: 3330, date: "2025-02-28", count: 2,
+			want: []contest{{3331, "2025-03-05", ""}, {3332, "2025-03-06", ""}},
+		},
+		{
+			name: "terça de Carnaval na Dupla Sena", ltype: DuplaSena, number: 2900, date: "2026-02-14", count: 2,
+			want: []contest{{2901, "2026-02-19", ""}, {2902, "2026-02-21", ""}},
+		},
+		{
+			name: "véspera de Natal e Mega da Virada", ltype: MegaSena, number: 2950, date: "2025-12-20", count: 3,
+			want: []contest{{2951, "2025-12-27", ""}, {2952, "2025-12-31", "Mega da Virada"}, {2953, "2026-01-03", ""}},
+		},
+		{
+			name: "fim de ano na Quina", ltype: Quina, number: 6900, date: "2025-12-23", count: 5,
+			want: []contest{
+				{6901, "2025-12-26", ""}, {6902, "2025-12-27", ""}, {6903, "2025-12-29", ""},
+				{6904, "2025-12-30", ""}, {6905, "2026-01-02", ""},
+			},
+		},
+		{
+			name: "Quina de São João sem data padrão", ltype: Quina, number: 6750, date: "2025-06-22", count: 2,
+			want: []contest{{6751, "2025-06-23", ""}, {6752, "2025-06-24", ""}},
+		},
+		{
+			name: "nenhum concurso pedido", ltype: MegaSena, number: 2950, date: "2025-12-20", count: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ProjectContests(tt.ltype, tt.number, apiDate(tt.date).Time(), tt.count)
+			assertContests(t, got, tt.want)
+		})
+	}
+}
+
+func TestUpcomingContests(t *testing.T) {
+	tests := []struct {
+		name   string
+		ltype  LotteryType
+		latest Draw
+		now    string
+		count  int
+		want   []contest
+	}{
+		{
+			name:   "próximo concurso informado pela CAIXA",
+			ltype:  MegaSena,
+			latest: Draw{Number: 2950, Date: apiDate("2025-12-20"), NextDrawNumber: 2951, NextDrawDate: apiDate("2025-12-27")},
+			now:    "2025-12-21 10:00", count: 3,
+			want: []contest{{2951, "2025-12-27", ""}, {2952, "2025-12-31", "Mega da Virada"}, {2953, "2026-01-03", ""}},
+		},
+		{
+			name:   "sorteio remarcado pela CAIXA fora do calendário",
+			ltype:  MegaSena,
+			latest: Draw{Number: 2950, Date: apiDate("2025-12-20"), NextDrawNumber: 2951, NextDrawDate: apiDate("2025-12-26")},
+			now:    "2025-12-21 10:00", count: 3,
+			want: []contest{{2951, "2025-12-26", ""}, {2952, "2025-12-27", ""}, {2953, "2025-12-31", "Mega da Virada"}},
+		},
+		{
+			name:   "dados locais desatualizados avançam pelo calendário",
+			ltype:  MegaSena,
+			latest: Draw{Number: 2950, Date: apiDate("2025-12-20"), NextDrawNumber: 2951, NextDrawDate: apiDate("2025-12-27")},
+			now:    "2026-01-01 10:00", count: 2,
+			want: []contest{{2953, "2026-01-03", ""}, {2954, "2026-01-07", ""}},
+		},
+		{
+			name:   "dia da Mega da Virada antes do sorteio",
+			ltype:  MegaSena,
+			latest: Draw{Number: 2951, Date: apiDate("2025-12-27")},
+			now:    "2025-12-31 15:00", count: 1,
+			want: []contest{{2952, "2025-12-31", "Mega da Virada"}},
+		},
+		{
+			name:   "dia da Mega da Virada depois do sorteio",
+			ltype:  MegaSena,
+			latest: Draw{Number: 2951, Date: apiDate("2025-12-27")},
+			now:    "2025-12-31 21:00", count: 1,
+			want: []contest{{2953, "2026-01-03", ""}},
+		},
+		{
+			name:   "Carnaval sem o próximo concurso informado",
+			ltype:  Lotofacil,
+			latest: Draw{Number: 3330, Date: apiDate("2025-02-28")},
+			now:    "2025-03-01 09:00", count: 1,
+			want: []contest{{3331, "2025-03-05", ""}},
+		},
+		{
+			name:   "sem sorteio conhecido",
+			ltype:  MegaSena,
+			latest: Draw{},
+			now:    "2025-12-21 10:00", count: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := UpcomingContests(tt.ltype, tt.latest, saoPauloTime(tt.now), tt.count)
+			assertContests(t, got, tt.want)
+		})
+	}
+}
+
+func TestCalendarOverrides(t *testing.T) {
+	t.Cleanup(func() { SetCalendarOverrides(nil, nil) })
+	SetCalendarOverrides(
+		[]NoDrawDay{{Date: apiDate("2025-03-03").Time(), Name: "Carnaval"}},
+		[]SpecialDrawDate{
+			{Lottery: Lotofacil, Name: "Lotofácil da Independência", Date: apiDate("2025-09-05").Time()},
+			{Lottery: Quina, Name: "Quina de São João", Date: apiDate("2025-06-22").Time()},
+			{Lottery: MegaSena, Name: "Mega da Virada", Date: apiDate("2026-12-29").Time()},
+		},
+	)
+
+	tests := []struct {
+		name   string
+		ltype  LotteryType
+		number int
+		date   string
+		count  int
+		want   []contest
+	}{
+		{
+			name: "ano informado usa só a lista configurada", ltype: Lotofacil, number: 3330, date: "2025-02-28", count: 2,
+			want: []contest{{3331, "2025-03-04", ""}, {3332, "2025-03-05", ""}},
+		},
+		{
+			name: "concurso especial informado no ano", ltype: Lotofacil, number: 3480, date: "2025-09-04", count: 3,
+			want: []contest{{3481, "2025-09-05", "Lotofácil da Independência"}, {3482, "2025-09-08", ""}, {3483, "2025-09-09", ""}},
+		},
+		{
+			name: "concurso especial fora dos dias de sorteio não cria concurso", ltype: Quina, number: 6750, date: "2025-06-20", count: 2,
+			want: []contest{{6751, "2025-06-21", ""}, {6752, "2025-06-23", ""}},
+		},
+		{
+			name: "Mega da Virada remarcada continua fora dos dias regulares", ltype: MegaSena, number: 3000, date: "2026-12-26", count: 2,
+			want: []contest{{3001, "2026-12-29", "Mega da Virada"}, {3002, "2026-12-30", ""}},
+		},
+		{
+			name: "outros anos seguem o padrão", ltype: Lotofacil, number: 3600, date: "2026-02-13", count: 1,
+			want: []contest{{3601, "2026-02-18", ""}},
+		},
+		{
+			name: "outras loterias mantêm os concursos especiais", ltype: MegaSena, number: 2951, date: "2025-12-27", count: 1,
+			want: []contest{{2952, "2025-12-31", "Mega da Virada"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ProjectContests(tt.ltype, tt.number, apiDate(tt.date).Time(), tt.count)
+			assertContests(t, got, tt.want)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package lottery
+
+import "time"
+
+// DiaDeSorte identifica o Dia de Sorte
+const DiaDeSorte LotteryType = "diadesorte"
+
+func init() {
+	Register(Definition{
+		Type:    DiaDeSorte,
+		Aliases: []string{"dia-de-sorte"},
+		Emoji:   "📅",
+		Order:   80,
+		// Dia de Sorte: 7 a 15 dezenas de 1 a 31 mais o Mês da Sorte
+		Rules: LotteryRules{
+			Name:             "Dia de Sorte",
+			MinNumbers:       7,
+			MaxNumbers:       15,
+			NumberRange:      31,
+			DrawDays:         []time.Weekday{time.Tuesday, time.Thursday, time.Saturday},
+			ResultNumbers:    7,
+			ExtraPickKind:    ExtraPickMonth,
+			ExtraPickName:    "Mês da Sorte",
+			ExtraPickOptions: Months,
+			// O Mês da Sorte é uma faixa à parte e soma com a das dezenas
+			PrizeTiers: []PrizeTier{
+				{Faixa: 1, Name: "7 acertos", Hits: 7},
+				{Faixa: 2, Name: "6 acertos", Hits: 6},
+				{Faixa: 3, Name: "5 acertos", Hits: 5},
+				{Faixa: 4, Name: "4 acertos", Hits: 4},
+				{Faixa: 5, Name: "Mês da Sorte", ExtraPick: true},
+			},
+		},
+		// Reajustes oficiais da CAIXA; jogos múltiplos custam C(dezenas, 7) apostas simples
+		Prices: []PriceChange{
+			{Effective: effective(2023, time.April, 30), BasePrice: 2.50},
+		},
+		SampleSize:      150, // ~1 ano de dados (3x por semana)
+		AveragePrize:    500000.0,
+		StrategyNote:    "com Mês da Sorte",
+		PromptPriceNote: "(+ Mês da Sorte)",
+		PromptRules:     "DIA DE SORTE: entre 7 e 15 números entre 1 e 31 E o campo \"extraPick\" com o Mês da Sorte (ex: \"Agosto\")",
+		InMixedStrategy: true,
+	})
+}
@@ -0,0 +1,46 @@
+package lottery
+
+import "time"
+
+// DuplaSena identifica a Dupla Sena
+const DuplaSena LotteryType = "duplasena"
+
+func init() {
+	Register(Definition{
+		Type:    DuplaSena,
+		Aliases: []string{"dupla-sena"},
+		Emoji:   "🎰",
+		Order:   50,
+		// Cada concurso da Dupla Sena tem dois sorteios independentes de 6 dezenas
+		Rules: LotteryRules{
+			Name:            "Dupla Sena",
+			MinNumbers:      6,
+			MaxNumbers:      15,
+			NumberRange:     50,
+			DrawDays:        []time.Weekday{time.Tuesday, time.Thursday, time.Saturday},
+			ResultNumbers:   6,
+			DrawsPerContest: 2,
+			// Faixas 1 a 4 pertencem ao 1º sorteio e 5 a 8 ao 2º
+			PrizeTiers: []PrizeTier{
+				{Faixa: 1, Name: "Sena (6 acertos)", Hits: 6, Draw: 1},
+				{Faixa: 2, Name: "Quina (5 acertos)", Hits: 5, Draw: 1},
+				{Faixa: 3, Name: "Quadra (4 acertos)", Hits: 4, Draw: 1},
+				{Faixa: 4, Name: "Terno (3 acertos)", Hits: 3, Draw: 1},
+				{Faixa: 5, Name: "Sena (6 acertos)", Hits: 6, Draw: 2},
+				{Faixa: 6, Name: "Quina (5 acertos)", Hits: 5, Draw: 2},
+				{Faixa: 7, Name: "Quadra (4 acertos)", Hits: 4, Draw: 2},
+				{Faixa: 8, Name: "Terno (3 acertos)", Hits: 3, Draw: 2},
+			},
+		},
+		// Jogos múltiplos custam C(dezenas, 6) apostas simples
+		Prices: []PriceChange{
+			{Effective: effective(2023, time.April, 30), BasePrice: 2.50},
+		},
+		SampleSize:      150,          // ~1 ano de dados (3x por semana)
+		AveragePrize:    2 * 800000.0, // 800 mil por sorteio, dois sorteios por concurso
+		StrategyNote:    "concorrendo em dois sorteios por concurso",
+		PromptPriceNote: "(cada aposta concorre nos 2 sorteios)",
+		PromptRules:     "DUPLA SENA: SEMPRE entre 6 e 15 números entre 1 e 50",
+		InMixedStrategy: true,
+	})
+}
@@ -0,0 +1,87 @@
+package lottery
+
+// SimpleBetHits agrupa as apostas simples de um desdobramento que tiveram a mesma conferência
+type SimpleBetHits struct {
+	Hits  Hits
+	Count int64 // Quantas apostas simples do jogo tiveram exatamente esses acertos
+}
+
+// ExpandHits desdobra uma aposta múltipla nas apostas simples equivalentes, agrupadas por acertos
+// Entre as C(n, k) apostas simples de um jogo com n dezenas e h acertos, C(h, j) x C(n-h, k-j) acertam j dezenas
+// A seleção secundária (ex: trevos) se desdobra da mesma forma; o palpite extra vale para todas as apostas simples
+func ExpandHits(rules LotteryRules, numCount int, hits Hits, secondaryCount int) []SimpleBetHits {
+	numbers := expandSelection(numCount, hits.Numbers, rules.MinNumbers, rules.MaxNumbers)
+
+	secondary := []SimpleBetHits{{Hits: Hits{Secondary: hits.Secondary}, Count: 1}}
+	if rules.HasSecondary() {
+		secondary = nil
+		for _, group := range expandSelection(secondaryCount, hits.Secondary, rules.SecondaryMin, rules.SecondaryMax) {
+			secondary = append(secondary, SimpleBetHits{Hits: Hits{Secondary: group.Hits.Numbers}, Count: group.Count})
+		}
+	}
+
+	var expanded []SimpleBetHits
+	for _, n := range numbers {
+		for _, s := range secondary {
+			expanded = append(expanded, SimpleBetHits{
+				Hits: Hits{
+					Numbers:   n.Hits.Numbers,
+					Secondary: s.Hits.Secondary,
+					ExtraPick: hits.ExtraPick,
+					DrawIndex: hits.DrawIndex,
+				},
+				Count: n.Count * s.Count,
+			})
+		}
+	}
+	return expanded
+}
+
+// expandSelection distribui os acertos de uma seleção de tamanho size entre as apostas simples de tamanho minimo
+// Fora da faixa de apostas múltiplas o jogo é tratado como uma única aposta simples
+func expandSelection(size, hitCount, minimum, maximum int) []SimpleBetHits {
+	if size <= minimum || size > maximum || hitCount > size {
+		return []SimpleBetHits{{Hits: Hits{Numbers: hitCount}, Count: 1}}
+	}
+
+	// Do maior para o menor número de acertos, para que a faixa principal apareça primeiro
+	var groups []SimpleBetHits
+	for j := minimum; j >= 0; j-- {
+		count := Combinations(hitCount, j) * Combinations(size-hitCount, minimum-j)
+		if count > 0 {
+			groups = append(groups, SimpleBetHits{Hits: Hits{Numbers: j}, Count: count})
+		}
+	}
+	return groups
+}
+
+// ExpandPositionHits desdobra apostas por posição (colunas da Super Sete, partidas da Loteca)
+// marked[i] é quantas opções foram marcadas na posição i e hit[i] se uma delas é a sorteada
+// Cada aposta simples escolhe uma opção por posição; a contagem sai do produto (acerto·x + erros)
+func ExpandPositionHits(marked []int, hit []bool) []SimpleBetHits {
+	distribution := []int64{1}
+	for i, options := range marked {
+		if options < 1 {
+			options = 1
+		}
+		hitOptions := 0
+		if i < len(hit) && hit[i] {
+			hitOptions = 1
+		}
+
+		next := make([]int64, len(distribution)+1)
+		for j, count := range distribution {
+			next[j] += count * int64(options-hitOptions)
+			next[j+1] += count * int64(hitOptions)
+		}
+		distribution = next
+	}
+
+	var groups []SimpleBetHits
+	for j := len(distribution) - 1; j >= 0; j-- {
+		if distribution[j] > 0 {
+			groups = append(groups, SimpleBetHits{Hits: Hits{Numbers: j}, Count: distribution[j]})
+		}
+	}
+	return groups
+}
@@ -0,0 +1,170 @@
+package lottery
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExpandHits(t *testing.T) {
+	tests := []struct {
+		name           string
+		ltype          LotteryType
+		numCount       int
+		hits           Hits
+		secondaryCount int
+		want           []SimpleBetHits
+	}{
+		{
+			name:     "mega simples",
+			ltype:    MegaSena,
+			numCount: 6,
+			hits:     Hits{Numbers: 4},
+			want:     []SimpleBetHits{{Hits: Hits{Numbers: 4}, Count: 1}},
+		},
+		{
+			name:     "mega 7 dezenas com 5 acertos",
+			ltype:    MegaSena,
+			numCount: 7,
+			hits:     Hits{Numbers: 5},
+			want: []SimpleBetHits{
+				{Hits: Hits{Numbers: 5}, Count: 2},
+				{Hits: Hits{Numbers: 4}, Count: 5},
+			},
+		},
+		{
+			name:     "mega 8 dezenas com 6 acertos",
+			ltype:    MegaSena,
+			numCount: 8,
+			hits:     Hits{Numbers: 6},
+			want: []SimpleBetHits{
+				{Hits: Hits{Numbers: 6}, Count: 1},
+				{Hits: Hits{Numbers: 5}, Count: 12},
+				{Hits: Hits{Numbers: 4}, Count: 15},
+			},
+		},
+		{
+			name:     "fora da faixa vira aposta única",
+			ltype:    MegaSena,
+			numCount: 25,
+			hits:     Hits{Numbers: 3},
+			want:     []SimpleBetHits{{Hits: Hits{Numbers: 3}, Count: 1}},
+		},
+		{
+			name:           "milionária simples",
+			ltype:          MaisMilionaria,
+			numCount:       6,
+			hits:           Hits{Numbers: 6, Secondary: 1},
+			secondaryCount: 2,
+			want:           []SimpleBetHits{{Hits: Hits{Numbers: 6, Secondary: 1}, Count: 1}},
+		},
+		{
+			name:           "milionária 3 trevos com 2 acertos",
+			ltype:          MaisMilionaria,
+			numCount:       6,
+			hits:           Hits{Numbers: 6, Secondary: 2},
+			secondaryCount: 3,
+			want: []SimpleBetHits{
+				{Hits: Hits{Numbers: 6, Secondary: 2}, Count: 1},
+				{Hits: Hits{Numbers: 6, Secondary: 1}, Count: 2},
+			},
+		},
+		{
+			name:           "milionária 7 dezenas e 3 trevos",
+			ltype:          MaisMilionaria,
+			numCount:       7,
+			hits:           Hits{Numbers: 6, Secondary: 1},
+			secondaryCount: 3,
+			want: []SimpleBetHits{
+				{Hits: Hits{Numbers: 6, Secondary: 1}, Count: 2},
+				{Hits: Hits{Numbers: 6, Secondary: 0}, Count: 1},
+				{Hits: Hits{Numbers: 5, Secondary: 1}, Count: 12},
+				{Hits: Hits{Numbers: 5, Secondary: 0}, Count: 6},
+			},
+		},
+		{
+			name:     "palpite extra e sorteio valem para todas as apostas",
+			ltype:    DiaDeSorte,
+			numCount: 8,
+			hits:     Hits{Numbers: 7, ExtraPick: true, DrawIndex: 1},
+			want: []SimpleBetHits{
+				{Hits: Hits{Numbers: 7, ExtraPick: true, DrawIndex: 1}, Count: 1},
+				{Hits: Hits{Numbers: 6, ExtraPick: true, DrawIndex: 1}, Count: 7},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExpandHits(GetRules(tt.ltype), tt.numCount, tt.hits, tt.secondaryCount)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ExpandHits() = %+v, want %+v", got, tt.want)
+			}
+			if want := totalCount(tt.want); totalCount(got) != want {
+				t.Errorf("total de apostas simples = %d, want %d", totalCount(got), want)
+			}
+		})
+	}
+}
+
+func TestExpandPositionHits(t *testing.T) {
+	tests := []struct {
+		name   string
+		marked []int
+		hit    []bool
+		want   []SimpleBetHits
+	}{
+		{
+			name:   "uma marcação por coluna",
+			marked: []int{1, 1, 1, 1, 1, 1, 1},
+			hit:    []bool{true, true, false, false, false, false, false},
+			want:   []SimpleBetHits{{Hits: Hits{Numbers: 2}, Count: 1}},
+		},
+		{
+			name:   "coluna dupla acertada",
+			marked: []int{2, 1, 1},
+			hit:    []bool{true, false, true},
+			want: []SimpleBetHits{
+				{Hits: Hits{Numbers: 2}, Count: 1},
+				{Hits: Hits{Numbers: 1}, Count: 1},
+			},
+		},
+		{
+			name:   "triplo em todas as partidas",
+			marked: []int{3, 3},
+			hit:    []bool{true, true},
+			want: []SimpleBetHits{
+				{Hits: Hits{Numbers: 2}, Count: 1},
+				{Hits: Hits{Numbers: 1}, Count: 4},
+				{Hits: Hits{Numbers: 0}, Count: 4},
+			},
+		},
+		{
+			name:   "sem resultado para a coluna",
+			marked: []int{3},
+			hit:    nil,
+			want:   []SimpleBetHits{{Hits: Hits{Numbers: 0}, Count: 3}},
+		},
+		{
+			name:   "coluna sem marcação conta como uma",
+			marked: []int{0},
+			hit:    []bool{true},
+			want:   []SimpleBetHits{{Hits: Hits{Numbers: 1}, Count: 1}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExpandPositionHits(tt.marked, tt.hit); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ExpandPositionHits(%v, %v) = %+v, want %+v", tt.marked, tt.hit, got, tt.want)
+			}
+		})
+	}
+}
+
+func totalCount(groups []SimpleBetHits) int64 {
+	var total int64
+	for _, group := range groups {
+		total += group.Count
+	}
+	return total
+}
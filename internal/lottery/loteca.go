@@ -0,0 +1,179 @@
+package lottery
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Loteca identifica a Loteca
+const Loteca LotteryType = "loteca"
+
+// Resultados possíveis de uma partida da Loteca
+const (
+	OutcomeHome = "1" // Vitória da equipe da coluna 1 (mandante)
+	OutcomeDraw = "X" // Empate (coluna do meio)
+	OutcomeAway = "2" // Vitória da equipe da coluna 2 (visitante)
+)
+
+func init() {
+	Register(Definition{
+		Type:  Loteca,
+		Emoji: "🏟️",
+		Order: 100,
+		// Loteca: palpite 1/X/2 em 14 partidas; a aposta mínima já inclui um duplo
+		Rules: LotteryRules{
+			Name:     "Loteca",
+			DrawDays: []time.Weekday{time.Monday}, // Apuração após a rodada do fim de semana
+			Matches:  14,
+			PrizeTiers: []PrizeTier{
+				{Faixa: 1, Name: "14 acertos", Hits: 14},
+				{Faixa: 2, Name: "13 acertos", Hits: 13},
+			},
+		},
+		// O custo depende dos duplos e triplos marcados (ver CalculateMatchPoolCost)
+		Prices: []PriceChange{
+			{Effective: effective(2023, time.April, 30), BasePrice: 4.00},
+		},
+		// Sem dezenas, a Loteca fica fora da análise de temperatura (SampleSize zero)
+		AveragePrize:    500000.0,
+		StrategyNote:    "com palpites nas 14 partidas",
+		PromptRules:     "LOTECA: campo \"picks\" com 14 palpites \"1\", \"X\" ou \"2\" (duplos \"1X\", \"12\", \"X2\" e triplo \"1X2\"), ao menos um duplo, e \"numbers\" vazio",
+		InMixedStrategy: true,
+	})
+}
+
+// LotecaMatch representa uma partida da grade da Loteca como publicada pela CAIXA
+type LotecaMatch struct {
+	Sequence  int    `json:"nuSequencial"`
+	HomeTeam  string `json:"nomeEquipeUm"`
+	AwayTeam  string `json:"nomeEquipeDois"`
+	HomeGoals *int   `json:"nuGolEquipeUm"`   // nil enquanto a partida não foi apurada
+	AwayGoals *int   `json:"nuGolEquipeDois"` // nil enquanto a partida não foi apurada
+	Date      string `json:"dtJogo"`
+	Weekday   string `json:"diaSemana"`
+}
+
+// Outcome retorna o resultado da partida ("1", "X" ou "2"), vazio se ainda não houver placar
+func (m LotecaMatch) Outcome() string {
+	if m.HomeGoals == nil || m.AwayGoals == nil {
+		return ""
+	}
+
+	switch {
+	case *m.HomeGoals > *m.AwayGoals:
+		return OutcomeHome
+	case *m.HomeGoals < *m.AwayGoals:
+		return OutcomeAway
+	default:
+		return OutcomeDraw
+	}
+}
+
+// MatchOutcomes retorna o resultado de cada partida do concurso na ordem da grade
+func (d Draw) MatchOutcomes() []string {
+	outcomes := make([]string, len(d.Matches))
+	for i, match := range d.Matches {
+		outcomes[i] = match.Outcome()
+	}
+	return outcomes
+}
+
+// NormalizeMatchPick valida um palpite de partida e o retorna na forma canônica
+// Aceita combinações de "1", "X" e "2" em qualquer ordem (ex: "x1" -> "1X", "2-1" -> "12")
+func NormalizeMatchPick(pick string) (string, error) {
+	cleaned := strings.ToUpper(strings.TrimSpace(pick))
+	cleaned = strings.NewReplacer(" ", "", "-", "", "/", "", ",", "").Replace(cleaned)
+
+	if cleaned == "" {
+		return "", fmt.Errorf("palpite vazio")
+	}
+
+	seen := make(map[rune]bool)
+	for _, r := range cleaned {
+		switch string(r) {
+		case OutcomeHome, OutcomeDraw, OutcomeAway:
+		default:
+			return "", fmt.Errorf("palpite %q inválido: use 1, X ou 2", pick)
+		}
+		if seen[r] {
+			return "", fmt.Errorf("palpite %q repetido", pick)
+		}
+		seen[r] = true
+	}
+
+	normalized := ""
+	for _, outcome := range []string{OutcomeHome, OutcomeDraw, OutcomeAway} {
+		if seen[rune(outcome[0])] {
+			normalized += outcome
+		}
+	}
+	return normalized, nil
+}
+
+// validateMatchPoolGame valida os palpites por partida da Loteca
+func validateMatchPoolGame(game Game, rules LotteryRules) error {
+	if len(game.Numbers) > 0 {
+		return fmt.Errorf("%s usa palpites por partida, não uma lista de dezenas", rules.Name)
+	}
+
+	if len(game.Picks) != rules.Matches {
+		return fmt.Errorf("número de palpites inválido para %s: deve ter exatamente %d", rules.Name, rules.Matches)
+	}
+
+	multiple := 0
+	for i, pick := range game.Picks {
+		normalized, err := NormalizeMatchPick(pick)
+		if err != nil {
+			return fmt.Errorf("jogo %d: %v", i+1, err)
+		}
+		if len(normalized) > 1 {
+			multiple++
+		}
+	}
+
+	// A aposta mínima já inclui um duplo, por isso ao menos um palpite deve ser duplo ou triplo
+	if multiple == 0 {
+		return fmt.Errorf("%s exige ao menos um palpite duplo ou triplo", rules.Name)
+	}
+
+	return nil
+}
+
+// NormalizeMatchPicks retorna todos os palpites na forma canônica
+func NormalizeMatchPicks(picks []string) ([]string, error) {
+	normalized := make([]string, len(picks))
+	for i, pick := range picks {
+		value, err := NormalizeMatchPick(pick)
+		if err != nil {
+			return nil, fmt.Errorf("jogo %d: %v", i+1, err)
+		}
+		normalized[i] = value
+	}
+	return normalized, nil
+}
+
+// CalculateMatchPoolCost calcula o custo da Loteca pelos duplos e triplos marcados com o preço atual
+// Cada duplo dobra e cada triplo triplica as combinações; o preço base já cobre um duplo
+func CalculateMatchPoolCost(ltype LotteryType, picks []string) float64 {
+	return float64(matchPoolCombinations(picks)) / 2 * GetRules(ltype).BasePrice
+}
+
+// matchPoolCombinations conta as combinações de resultados cobertas pelos palpites
+func matchPoolCombinations(picks []string) int64 {
+	combinations := int64(1)
+	for _, pick := range picks {
+		if normalized, err := NormalizeMatchPick(pick); err == nil {
+			combinations *= int64(len(normalized))
+		}
+	}
+	return combinations
+}
+
+// sortMatches ordena as partidas pela sequência da grade
+func sortMatches(matches []LotecaMatch) {
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Sequence < matches[j].Sequence
+	})
+}
@@ -0,0 +1,41 @@
+package lottery
+
+import "time"
+
+// Lotofacil identifica a Lotofácil
+const Lotofacil LotteryType = "lotofacil"
+
+func init() {
+	Register(Definition{
+		Type:    Lotofacil,
+		Aliases: []string{"loto-facil", "Lotofácil"},
+		Emoji:   "🍀",
+		Order:   20,
+		Rules: LotteryRules{
+			Name:          "Lotofácil",
+			MinNumbers:    15,
+			MaxNumbers:    20,
+			NumberRange:   25,
+			DrawDays:      []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+			ResultNumbers: 15,
+			// 11 a 13 pontos pagam valores fixos desde o reajuste de 2023
+			PrizeTiers: []PrizeTier{
+				{Faixa: 1, Name: "15 acertos", Hits: 15},
+				{Faixa: 2, Name: "14 acertos", Hits: 14},
+				{Faixa: 3, Name: "13 acertos", Hits: 13, FixedPrize: 30.00},
+				{Faixa: 4, Name: "12 acertos", Hits: 12, FixedPrize: 12.00},
+				{Faixa: 5, Name: "11 acertos", Hits: 11, FixedPrize: 6.00},
+			},
+		},
+		// Reajustes oficiais da CAIXA; jogos múltiplos custam C(dezenas, 15) apostas simples
+		Prices: []PriceChange{
+			{Effective: effective(2019, time.November, 10), BasePrice: 2.50},
+			{Effective: effective(2023, time.April, 30), BasePrice: 3.00},
+		},
+		SampleSize:      300, // ~10 meses de dados (diário)
+		AveragePrize:    500000.0,
+		StrategyNote:    "para maior frequência de ganhos",
+		PromptRules:     "LOTOFÁCIL: SEMPRE 15, 16, 17, 18, 19 ou 20 números (NUNCA MENOS QUE 15!)",
+		InMixedStrategy: true,
+	})
+}
@@ -0,0 +1,52 @@
+package lottery
+
+import "time"
+
+// Lotomania identifica a Lotomania
+const Lotomania LotteryType = "lotomania"
+
+func init() {
+	Register(Definition{
+		Type:  Lotomania,
+		Emoji: "🎲",
+		Order: 40,
+		// Na Lotomania a dezena "00" é representada como 100
+		Rules: LotteryRules{
+			Name:          "Lotomania",
+			MinNumbers:    50,
+			MaxNumbers:    50,
+			NumberRange:   100,
+			DrawDays:      []time.Weekday{time.Monday, time.Wednesday, time.Friday},
+			ResultNumbers: 20,
+			MirrorBets:    true,
+			PrizeTiers: []PrizeTier{
+				{Faixa: 1, Name: "20 acertos", Hits: 20},
+				{Faixa: 2, Name: "19 acertos", Hits: 19},
+				{Faixa: 3, Name: "18 acertos", Hits: 18},
+				{Faixa: 4, Name: "17 acertos", Hits: 17},
+				{Faixa: 5, Name: "16 acertos", Hits: 16},
+				{Faixa: 6, Name: "15 acertos", Hits: 15},
+				{Faixa: 7, Name: "0 acertos", Hits: 0},
+			},
+		},
+		// Lotomania tem aposta única de 50 dezenas: C(50, 50) = 1 aposta simples
+		Prices: []PriceChange{
+			{Effective: effective(2023, time.April, 30), BasePrice: 3.00},
+		},
+		NormalizeDraw:   normalizeLotomaniaDraw,
+		SampleSize:      150, // ~1 ano de dados (3x por semana)
+		AveragePrize:    1500000.0,
+		StrategyNote:    "com 50 números cada",
+		PromptRules:     "LOTOMANIA: SEMPRE EXATAMENTE 50 números entre 1 e 100 (100 representa a dezena 00)",
+		InMixedStrategy: true,
+	})
+}
+
+// normalizeLotomaniaDraw converte a dezena "00" publicada pela CAIXA para 100
+func normalizeLotomaniaDraw(draw *Draw) {
+	for i, num := range draw.Numbers {
+		if num == 0 {
+			draw.Numbers[i] = 100
+		}
+	}
+}
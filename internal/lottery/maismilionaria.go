@@ -0,0 +1,50 @@
+package lottery
+
+import "time"
+
+// MaisMilionaria identifica a +Milionária
+const MaisMilionaria LotteryType = "maismilionaria"
+
+func init() {
+	Register(Definition{
+		Type:    MaisMilionaria,
+		Aliases: []string{"+milionaria", "mais-milionaria"},
+		Emoji:   "💰",
+		Order:   60,
+		// +Milionária: 6 a 12 dezenas de 1 a 50 mais 2 a 6 trevos de 1 a 6
+		Rules: LotteryRules{
+			Name:             "+Milionária",
+			MinNumbers:       6,
+			MaxNumbers:       12,
+			NumberRange:      50,
+			DrawDays:         []time.Weekday{time.Wednesday, time.Saturday},
+			ResultNumbers:    6,
+			SecondaryName:    "trevos",
+			SecondaryMin:     2,
+			SecondaryMax:     6,
+			SecondaryRange:   6,
+			SecondaryResults: 2,
+			// De 4 a 6 acertos, 1 ou nenhum trevo formam a mesma faixa; 2 e 3 acertos pagam valores fixos
+			PrizeTiers: []PrizeTier{
+				{Faixa: 1, Name: "6 acertos + 2 trevos", Hits: 6, Secondary: []int{2}},
+				{Faixa: 2, Name: "6 acertos + 1 ou nenhum trevo", Hits: 6, Secondary: []int{0, 1}},
+				{Faixa: 3, Name: "5 acertos + 2 trevos", Hits: 5, Secondary: []int{2}},
+				{Faixa: 4, Name: "5 acertos + 1 ou nenhum trevo", Hits: 5, Secondary: []int{0, 1}},
+				{Faixa: 5, Name: "4 acertos + 2 trevos", Hits: 4, Secondary: []int{2}},
+				{Faixa: 6, Name: "4 acertos + 1 ou nenhum trevo", Hits: 4, Secondary: []int{0, 1}},
+				{Faixa: 7, Name: "3 acertos + 2 trevos", Hits: 3, Secondary: []int{2}, FixedPrize: 50.00},
+				{Faixa: 8, Name: "3 acertos + 1 trevo", Hits: 3, Secondary: []int{1}, FixedPrize: 24.00},
+				{Faixa: 9, Name: "2 acertos + 2 trevos", Hits: 2, Secondary: []int{2}, FixedPrize: 12.00},
+				{Faixa: 10, Name: "2 acertos + 1 trevo", Hits: 2, Secondary: []int{1}, FixedPrize: 6.00},
+			},
+		},
+		Prices: []PriceChange{
+			{Effective: effective(2022, time.May, 28), BasePrice: 6.00},
+		},
+		SampleSize:      100,        // ~1 ano de dados (2x por semana)
+		AveragePrize:    10000000.0, // prêmio mínimo de 10 milhões
+		StrategyNote:    "com dezenas e trevos",
+		PromptRules:     "+MILIONÁRIA: entre 6 e 12 números entre 1 e 50 E o campo \"secondary\" com 2 a 6 trevos entre 1 e 6",
+		InMixedStrategy: true,
+	})
+}
@@ -0,0 +1,39 @@
+package lottery
+
+import "time"
+
+// MegaSena identifica a Mega-Sena
+const MegaSena LotteryType = "megasena"
+
+func init() {
+	Register(Definition{
+		Type:    MegaSena,
+		Aliases: []string{"mega-sena", "Mega-Sena", "mega"},
+		Emoji:   "🎯",
+		Order:   10,
+		Rules: LotteryRules{
+			Name:          "Mega Sena",
+			MinNumbers:    6,
+			MaxNumbers:    20,
+			NumberRange:   60,
+			DrawDays:      []time.Weekday{time.Wednesday, time.Saturday},
+			SpecialDraws:  []SpecialDraw{{Name: "Mega da Virada", Month: time.December, Day: 31, OffSchedule: true}},
+			ResultNumbers: 6,
+			PrizeTiers: []PrizeTier{
+				{Faixa: 1, Name: "Sena (6 acertos)", Hits: 6},
+				{Faixa: 2, Name: "Quina (5 acertos)", Hits: 5},
+				{Faixa: 3, Name: "Quadra (4 acertos)", Hits: 4},
+			},
+		},
+		// Reajustes oficiais da CAIXA; jogos múltiplos custam C(dezenas, 6) apostas simples
+		Prices: []PriceChange{
+			{Effective: effective(2019, time.November, 10), BasePrice: 4.50},
+			{Effective: effective(2023, time.April, 30), BasePrice: 5.00},
+		},
+		SampleSize:      200, // ~2 anos de dados (2x por semana)
+		AveragePrize:    1000000.0,
+		StrategyNote:    "para maximizar prêmios altos",
+		PromptRules:     "MEGA-SENA: SEMPRE 6, 7, 8, 9, 10, 11 ou 12 números (NUNCA MENOS QUE 6!)",
+		InMixedStrategy: true,
+	})
+}
@@ -0,0 +1,196 @@
+package lottery
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// PriceChange preço da aposta simples em vigor a partir de uma data
+type PriceChange struct {
+	Effective time.Time
+	BasePrice float64
+}
+
+// effective retorna a data de início de vigência de um preço
+func effective(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// sortPrices ordena a tabela de preços pela data de vigência
+func sortPrices(prices []PriceChange) {
+	sort.SliceStable(prices, func(i, j int) bool {
+		return prices[i].Effective.Before(prices[j].Effective)
+	})
+}
+
+// BasePriceOn retorna o preço da aposta simples em vigor na data informada
+// Datas anteriores à tabela usam o preço mais antigo; data zero usa o preço atual
+func BasePriceOn(ltype LotteryType, date time.Time) float64 {
+	def, ok := Get(ltype)
+	if !ok {
+		return 0
+	}
+	if len(def.Prices) == 0 || date.IsZero() {
+		return def.Rules.BasePrice
+	}
+
+	price := def.Prices[0].BasePrice
+	for _, change := range def.Prices {
+		if date.Before(change.Effective) {
+			break
+		}
+		price = change.BasePrice
+	}
+	return price
+}
+
+// SimpleBets retorna quantas apostas simples equivalem a um jogo com numCount dezenas
+// Por padrão C(dezenas, mínimo); loterias com seleção secundária multiplicam por C(trevos, mínimo)
+// Em apostas por colunas o total de marcações não define o custo (8+1+1+1+1+1+1 são 8 apostas, não 128):
+// só o jogo simples, com uma marcação por coluna, é aceito; os demais usam GameCostOn com as colunas
+// Quantidades fora das regras da loteria retornam erro
+func SimpleBets(ltype LotteryType, numCount, secondaryCount int) (int64, error) {
+	def, ok := Get(ltype)
+	if !ok {
+		return 0, fmt.Errorf("tipo de loteria não suportado: %s", ltype)
+	}
+
+	rules := def.Rules
+	if rules.IsPositional() {
+		if numCount != rules.Columns {
+			return 0, fmt.Errorf("custo da %s com %d marcações depende das marcações de cada coluna", rules.Name, numCount)
+		}
+		return 1, nil
+	}
+	if rules.HasSecondary() {
+		return secondarySimpleBets(rules, numCount, secondaryCount)
+	}
+	if numCount < rules.MinNumbers || numCount > rules.MaxNumbers {
+		return 0, fmt.Errorf("número de dezenas inválido para %s: %d (deve estar entre %d e %d)",
+			rules.Name, numCount, rules.MinNumbers, rules.MaxNumbers)
+	}
+	return Combinations(numCount, rules.MinNumbers), nil
+}
+
+// CalculateGameCostOn calcula o custo pela quantidade de dezenas com o preço em vigor na data
+// Loterias com seleção secundária consideram o mínimo de trevos (ver GameCostOn para o jogo completo)
+func CalculateGameCostOn(ltype LotteryType, numCount int, date time.Time) (float64, error) {
+	simpleBets, err := SimpleBets(ltype, numCount, GetRules(ltype).SecondaryMin)
+	if err != nil {
+		return 0, err
+	}
+	return float64(simpleBets) * BasePriceOn(ltype, date), nil
+}
+
+// GameCostOn calcula o custo de um jogo com o preço em vigor na data do concurso
+func GameCostOn(game Game, date time.Time) (float64, error) {
+	rules := GetRules(game.Type)
+	basePrice := BasePriceOn(game.Type, date)
+
+	switch {
+	case rules.IsMatchPool():
+		return float64(matchPoolCombinations(game.Picks)) / 2 * basePrice, nil
+	case rules.IsPositional():
+		if len(game.Columns) != rules.Columns {
+			return 0, fmt.Errorf("aposta da %s sem as %d colunas (%d informadas)", rules.Name, rules.Columns, len(game.Columns))
+		}
+		for i, column := range game.Columns {
+			if len(column) == 0 {
+				return 0, fmt.Errorf("coluna %d da %s sem marcações", i+1, rules.Name)
+			}
+		}
+		return float64(positionalSimpleBets(game.Columns)) * basePrice, nil
+	default:
+		simpleBets, err := SimpleBets(game.Type, len(game.Numbers), len(game.Secondary))
+		if err != nil {
+			return 0, err
+		}
+		return float64(simpleBets) * basePrice, nil
+	}
+}
+
+// PriceList monta a tabela de preços de uma loteria em vigor na data (usada no prompt da IA)
+func PriceList(ltype LotteryType, date time.Time) string {
+	def, ok := Get(ltype)
+	if !ok {
+		return ""
+	}
+
+	rules := def.Rules
+	basePrice := BasePriceOn(ltype, date)
+	var entries []string
+	var formula string
+
+	switch {
+	case rules.IsMatchPool():
+		// Combinações de duplos e triplos; o preço base já cobre um duplo
+		for _, combo := range []struct {
+			label            string
+			doubles, triples int
+		}{
+			{"1 duplo", 1, 0}, {"2 duplos", 2, 0}, {"1 triplo", 0, 1}, {"1 duplo + 1 triplo", 1, 1},
+		} {
+			combinations := math.Pow(2, float64(combo.doubles)) * math.Pow(3, float64(combo.triples))
+			entries = append(entries, fmt.Sprintf("%s→%s", combo.label, formatReais(combinations/2*basePrice)))
+		}
+		formula = fmt.Sprintf("(2^duplos × 3^triplos ÷ 2 × %s)", formatReais(basePrice))
+	case rules.IsPositional():
+		entries = append(entries, fmt.Sprintf("1 dígito por coluna→%s", formatReais(basePrice)))
+		formula = fmt.Sprintf("| custo = produto das marcações de cada coluna × %s (ex: 2 colunas com 2 dígitos→%s)",
+			formatReais(basePrice), formatReais(4*basePrice))
+	case rules.HasSecondary():
+		for _, combo := range [][2]int{{0, 0}, {0, 1}, {1, 0}, {0, 2}, {2, 0}} {
+			numCount, secondaryCount := rules.MinNumbers+combo[0], rules.SecondaryMin+combo[1]
+			simpleBets, _ := secondarySimpleBets(rules, numCount, secondaryCount)
+			cost := float64(simpleBets) * basePrice
+			label := fmt.Sprintf("%d+%d", numCount, secondaryCount)
+			if len(entries) == 0 {
+				label += " " + rules.SecondaryName
+			}
+			entries = append(entries, fmt.Sprintf("%s→%s", label, formatReais(cost)))
+		}
+		formula = fmt.Sprintf("(C(dezenas,%d) × C(%s,%d) × %s)",
+			rules.MinNumbers, rules.SecondaryName, rules.SecondaryMin, formatReais(basePrice))
+	case rules.MinNumbers == rules.MaxNumbers:
+		entries = append(entries, fmt.Sprintf("%d→%s", rules.MinNumbers, formatReais(basePrice)))
+		formula = fmt.Sprintf("(aposta única de %d números)", rules.MinNumbers)
+	default:
+		// Até 7 quantidades a partir do jogo mínimo
+		maxListed := rules.MinNumbers + 6
+		if maxListed > rules.MaxNumbers {
+			maxListed = rules.MaxNumbers
+		}
+		for numCount := rules.MinNumbers; numCount <= maxListed; numCount++ {
+			cost, _ := CalculateGameCostOn(ltype, numCount, date)
+			entries = append(entries, fmt.Sprintf("%d→%s", numCount, formatReais(cost)))
+		}
+	}
+
+	line := fmt.Sprintf("%s: %s", strings.ToUpper(def.Name()), strings.Join(entries, " | "))
+	if formula != "" {
+		line += " " + formula
+	}
+	if def.PromptPriceNote != "" {
+		line += " " + def.PromptPriceNote
+	}
+	return line
+}
+
+// formatReais formata valores no padrão brasileiro (ex: R$1.050,00)
+func formatReais(value float64) string {
+	cents := int64(math.Round(value * 100))
+	integer := fmt.Sprintf("%d", cents/100)
+
+	var grouped strings.Builder
+	for i, digit := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			grouped.WriteByte('.')
+		}
+		grouped.WriteRune(digit)
+	}
+
+	return fmt.Sprintf("R$%s,%02d", grouped.String(), cents%100)
+}
@@ -0,0 +1,181 @@
+package lottery
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCombinations(t *testing.T) {
+	tests := []struct {
+		n, k int
+		want int64
+	}{
+		{6, 6, 1},
+		{7, 6, 7},
+		{10, 6, 210},
+		{20, 6, 38760},
+		{20, 15, 15504},
+		{60, 6, 50063860},
+		{5, 0, 1},
+		{5, 6, 0},
+		{5, -1, 0},
+	}
+
+	for _, tt := range tests {
+		if got := Combinations(tt.n, tt.k); got != tt.want {
+			t.Errorf("Combinations(%d, %d) = %d, want %d", tt.n, tt.k, got, tt.want)
+		}
+	}
+}
+
+func TestSimpleBets(t *testing.T) {
+	tests := []struct {
+		name           string
+		ltype          LotteryType
+		numCount       int
+		secondaryCount int
+		want           int64
+		wantErr        bool
+	}{
+		{"mega simples", MegaSena, 6, 0, 1, false},
+		{"mega 8 dezenas", MegaSena, 8, 0, 28, false},
+		{"mega abaixo do mínimo", MegaSena, 5, 0, 0, true},
+		{"mega acima do máximo", MegaSena, 21, 0, 0, true},
+		{"lotofácil 16 dezenas", Lotofacil, 16, 0, 16, false},
+		{"milionária simples", MaisMilionaria, 6, 2, 1, false},
+		{"milionária 3 trevos", MaisMilionaria, 6, 3, 3, false},
+		{"milionária 7 dezenas e 4 trevos", MaisMilionaria, 7, 4, 42, false},
+		{"milionária sem trevos", MaisMilionaria, 6, 0, 0, true},
+		{"milionária trevos demais", MaisMilionaria, 6, 7, 0, true},
+		{"milionária dezenas demais", MaisMilionaria, 13, 2, 0, true},
+		{"super sete simples", SuperSete, 7, 0, 1, false},
+		{"super sete 8 marcações sem as colunas", SuperSete, 8, 0, 0, true},
+		{"super sete marcações demais", SuperSete, 22, 0, 0, true},
+		{"loteria desconhecida", LotteryType("inexistente"), 6, 0, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SimpleBets(tt.ltype, tt.numCount, tt.secondaryCount)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SimpleBets() erro = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("SimpleBets() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBasePriceOn(t *testing.T) {
+	tests := []struct {
+		name  string
+		ltype LotteryType
+		date  time.Time
+		want  float64
+	}{
+		{"antes da tabela usa o preço mais antigo", MegaSena, effective(2018, time.January, 1), 4.50},
+		{"primeira faixa", MegaSena, effective(2021, time.June, 1), 4.50},
+		{"dia do reajuste", MegaSena, effective(2023, time.April, 30), 5.00},
+		{"véspera do reajuste", MegaSena, effective(2023, time.April, 29), 4.50},
+		{"data zero usa o preço atual", MegaSena, time.Time{}, 5.00},
+		{"quina antes do reajuste", Quina, effective(2022, time.December, 31), 2.00},
+		{"loteria desconhecida", LotteryType("inexistente"), time.Time{}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BasePriceOn(tt.ltype, tt.date); got != tt.want {
+				t.Errorf("BasePriceOn() = %.2f, want %.2f", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGameCostOn(t *testing.T) {
+	before := effective(2022, time.January, 15)
+	after := effective(2024, time.January, 15)
+	oneDouble := []string{"1X", "1", "2", "X", "1", "2", "X", "1", "2", "X", "1", "2", "X", "1"}
+
+	tests := []struct {
+		name    string
+		game    Game
+		date    time.Time
+		want    float64
+		wantErr bool
+	}{
+		{"mega 6 dezenas antes do reajuste", Game{Type: MegaSena, Numbers: seq(1, 6)}, before, 4.50, false},
+		{"mega 7 dezenas depois do reajuste", Game{Type: MegaSena, Numbers: seq(1, 7)}, after, 35.00, false},
+		{"lotofácil 16 dezenas antes do reajuste", Game{Type: Lotofacil, Numbers: seq(1, 16)}, before, 40.00, false},
+		{"milionária usa os trevos do jogo", Game{Type: MaisMilionaria, Numbers: seq(1, 6), Secondary: []int{1, 2, 3}}, after, 18.00, false},
+		{"milionária sem trevos", Game{Type: MaisMilionaria, Numbers: seq(1, 6)}, after, 0, true},
+		{"super sete com duas colunas duplas", Game{Type: SuperSete, Columns: [][]int{{1, 2}, {3, 4}, {5}, {6}, {7}, {8}, {9}}}, after, 10.00, false},
+		{"super sete com duas colunas triplas", Game{Type: SuperSete, Columns: [][]int{{0, 1, 2}, {3, 4, 5}, {6}, {7}, {8}, {9}, {0}}}, after, 22.50, false},
+		{"super sete sem colunas", Game{Type: SuperSete, Numbers: seq(1, 8)}, after, 0, true},
+		{"super sete com coluna vazia", Game{Type: SuperSete, Columns: [][]int{{1}, {}, {2}, {3}, {4}, {5}, {6}}}, after, 0, true},
+		{"loteca com um duplo", Game{Type: Loteca, Picks: oneDouble}, after, 4.00, false},
+		{"mega com dezenas demais", Game{Type: MegaSena, Numbers: seq(1, 21)}, after, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GameCostOn(tt.game, tt.date)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GameCostOn() erro = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("GameCostOn() = %.2f, want %.2f", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPriceList(t *testing.T) {
+	tests := []struct {
+		ltype LotteryType
+		date  time.Time
+		want  []string
+	}{
+		{MegaSena, effective(2022, time.January, 1), []string{"MEGA SENA:", "6→R$4,50", "7→R$31,50"}},
+		{MegaSena, effective(2024, time.January, 1), []string{"6→R$5,00", "10→R$1.050,00"}},
+		{MaisMilionaria, time.Time{}, []string{"6+2 trevos→R$6,00", "6+3→R$18,00", "8+2→R$168,00"}},
+		{SuperSete, time.Time{}, []string{"1 dígito por coluna→R$2,50"}},
+	}
+
+	for _, tt := range tests {
+		got := PriceList(tt.ltype, tt.date)
+		for _, want := range tt.want {
+			if !strings.Contains(got, want) {
+				t.Errorf("PriceList(%s, %s) = %q, sem %q", tt.ltype, tt.date.Format("2006-01-02"), got, want)
+			}
+		}
+	}
+}
+
+func TestFormatReais(t *testing.T) {
+	tests := []struct {
+		value float64
+		want  string
+	}{
+		{0, "R$0,00"},
+		{4.5, "R$4,50"},
+		{1050, "R$1.050,00"},
+		{1234567.891, "R$1.234.567,89"},
+	}
+
+	for _, tt := range tests {
+		if got := formatReais(tt.value); got != tt.want {
+			t.Errorf("formatReais(%v) = %s, want %s", tt.value, got, tt.want)
+		}
+	}
+}
+
+// seq retorna as dezenas de from a to
+func seq(from, to int) []int {
+	numbers := make([]int, 0, to-from+1)
+	for n := from; n <= to; n++ {
+		numbers = append(numbers, n)
+	}
+	return numbers
+}
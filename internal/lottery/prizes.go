@@ -0,0 +1,106 @@
+package lottery
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PrizeTier faixa de premiação declarada nas regras da loteria
+// O valor vem da faixa de mesmo índice na listaRateioPremio da CAIXA, nunca da descrição em texto
+type PrizeTier struct {
+	Faixa      int     // Índice da faixa no payload da CAIXA (1 = faixa principal)
+	Name       string  // Descrição exibida no resultado (ex: "Quina (5 acertos)")
+	Hits       int     // Dezenas, colunas ou partidas acertadas
+	Secondary  []int   // Acertos aceitos na seleção secundária (ex: trevos); vazio aceita qualquer quantidade
+	ExtraPick  bool    // Faixa paga pelo acerto do palpite extra, independente das dezenas
+	Draw       int     // Sorteio do concurso a que a faixa pertence (Dupla Sena); zero vale para todos
+	FixedPrize float64 // Prêmio fixo usado quando o payload não traz o valor da faixa
+}
+
+// Matches indica se a conferência de uma aposta simples se enquadra na faixa
+func (t PrizeTier) Matches(hits Hits) bool {
+	if t.ExtraPick {
+		return hits.ExtraPick
+	}
+
+	if t.Draw != 0 {
+		drawIndex := hits.DrawIndex
+		if drawIndex == 0 {
+			drawIndex = 1
+		}
+		if t.Draw != drawIndex {
+			return false
+		}
+	}
+
+	if t.Hits != hits.Numbers {
+		return false
+	}
+
+	if len(t.Secondary) == 0 {
+		return true
+	}
+	for _, secondary := range t.Secondary {
+		if secondary == hits.Secondary {
+			return true
+		}
+	}
+	return false
+}
+
+// TierPrizes indexa os valores do payload pela faixa
+// Payloads sem o campo "faixa" seguem a ordem da lista, que a CAIXA publica da faixa 1 em diante
+func TierPrizes(draw *Draw) map[int]float64 {
+	prizes := make(map[int]float64, len(draw.Winners))
+	for i, winner := range draw.Winners {
+		faixa := winner.Tier
+		if faixa == 0 {
+			faixa = i + 1
+		}
+		prizes[faixa] = winner.Prize
+	}
+	return prizes
+}
+
+// TierPrize retorna o valor de uma faixa no concurso, ou o prêmio fixo declarado se o payload não o trouxer
+func TierPrize(tier PrizeTier, prizes map[int]float64) float64 {
+	if value, exists := prizes[tier.Faixa]; exists && value > 0 {
+		return value
+	}
+	return tier.FixedPrize
+}
+
+// tieredPrize resolve a premiação pelas faixas declaradas nas regras
+// Faixas independentes (ex: dezenas e Time do Coração) se somam na mesma aposta
+func tieredPrize(rules LotteryRules) PrizeFunc {
+	return func(hits Hits, draw *Draw) (string, float64, bool) {
+		prizes := TierPrizes(draw)
+
+		var names []string
+		var total float64
+		for _, tier := range rules.PrizeTiers {
+			if !tier.Matches(hits) {
+				continue
+			}
+			names = append(names, tier.Name)
+			total += TierPrize(tier, prizes)
+		}
+
+		if len(names) == 0 {
+			return hitsDescription(rules, hits), 0, false
+		}
+		return strings.Join(names, " + "), total, true
+	}
+}
+
+// hitsDescription descreve uma conferência sem prêmio (ex: "3 acertos", "2 colunas")
+func hitsDescription(rules LotteryRules, hits Hits) string {
+	switch {
+	case rules.IsPositional():
+		return fmt.Sprintf("%d colunas", hits.Numbers)
+	case rules.HasSecondary():
+		return fmt.Sprintf("%d acertos + %d %s", hits.Numbers, hits.Secondary, rules.SecondaryName)
+	default:
+		return fmt.Sprintf("%d acertos", hits.Numbers)
+	}
+}
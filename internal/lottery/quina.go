@@ -0,0 +1,37 @@
+package lottery
+
+import "time"
+
+// Quina identifica a Quina
+const Quina LotteryType = "quina"
+
+func init() {
+	Register(Definition{
+		Type:  Quina,
+		Emoji: "🎱",
+		Order: 30,
+		Rules: LotteryRules{
+			Name:          "Quina",
+			MinNumbers:    5,
+			MaxNumbers:    15,
+			NumberRange:   80,
+			DrawDays:      []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday},
+			ResultNumbers: 5,
+			PrizeTiers: []PrizeTier{
+				{Faixa: 1, Name: "Quina (5 acertos)", Hits: 5},
+				{Faixa: 2, Name: "Quadra (4 acertos)", Hits: 4},
+				{Faixa: 3, Name: "Terno (3 acertos)", Hits: 3},
+				{Faixa: 4, Name: "Duque (2 acertos)", Hits: 2},
+			},
+		},
+		// Reajustes oficiais da CAIXA; jogos múltiplos custam C(dezenas, 5) apostas simples
+		Prices: []PriceChange{
+			{Effective: effective(2019, time.November, 10), BasePrice: 2.00},
+			{Effective: effective(2023, time.April, 30), BasePrice: 2.50},
+		},
+		SampleSize:      300, // ~1 ano de dados (6x por semana)
+		AveragePrize:    700000.0,
+		StrategyNote:    "para aproveitar os 6 sorteios semanais",
+		InMixedStrategy: true,
+	})
+}
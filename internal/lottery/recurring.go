@@ -0,0 +1,37 @@
+package lottery
+
+import (
+	"fmt"
+	"time"
+)
+
+// Limites da Teimosinha: a mesma aposta repetida em concursos consecutivos
+const (
+	MinRecurringContests = 2
+	MaxRecurringContests = 24
+)
+
+// ValidateRecurringContests verifica a quantidade de concursos de uma Teimosinha
+func ValidateRecurringContests(contests int) error {
+	if contests < MinRecurringContests || contests > MaxRecurringContests {
+		return fmt.Errorf("a Teimosinha deve ter entre %d e %d concursos, recebido %d",
+			MinRecurringContests, MaxRecurringContests, contests)
+	}
+	return nil
+}
+
+// NextDrawDates retorna as datas de count sorteios consecutivos a partir de first (inclusive)
+// Os sorteios seguintes seguem o calendário da loteria, pulando feriados e incluindo concursos especiais
+func NextDrawDates(ltype LotteryType, first time.Time, count int) []time.Time {
+	if count <= 0 {
+		return nil
+	}
+
+	dates := make([]time.Time, 0, count)
+	dates = append(dates, first)
+	for _, contest := range ProjectContests(ltype, 0, first, count-1) {
+		day := contest.Date
+		dates = append(dates, time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, first.Location()))
+	}
+	return dates
+}
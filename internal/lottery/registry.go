@@ -0,0 +1,146 @@
+package lottery
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Hits resume a conferência de um jogo contra um sorteio, usada para resolver a faixa de prêmio
+type Hits struct {
+	Numbers   int  // Dezenas (ou colunas/partidas) acertadas
+	Secondary int  // Acertos na seleção secundária (ex: trevos)
+	ExtraPick bool // Se acertou o palpite extra (Time do Coração, Mês da Sorte)
+	DrawIndex int  // Sorteio do concurso (1 ou 2 na Dupla Sena)
+}
+
+// PrizeFunc resolve a faixa de prêmio: descrição, valor e se o jogo foi premiado
+type PrizeFunc func(hits Hits, draw *Draw) (string, float64, bool)
+
+// Definition reúne tudo o que o app precisa saber sobre uma loteria
+// Cada loteria se registra no próprio arquivo; os subsistemas percorrem o registro
+type Definition struct {
+	Type    LotteryType
+	Slug    string   // Caminho na API da CAIXA (ex: "megasena")
+	Aliases []string // Grafias alternativas aceitas (ex: "mega-sena", "Mega-Sena")
+	Emoji   string
+	Order   int // Posição nas listagens (menus, estatísticas, próximos sorteios)
+
+	Rules LotteryRules
+	Prize PrizeFunc // nil resolve pelas faixas declaradas em Rules.PrizeTiers
+
+	// Preços da aposta simples com data de vigência; o mais recente vira Rules.BasePrice
+	Prices []PriceChange
+
+	// Ajustes do sorteio recebido da API para a representação interna (ex: "00" da Lotomania)
+	NormalizeDraw func(draw *Draw)
+
+	SampleSize      int     // Sorteios usados na análise de temperatura; zero exclui a loteria
+	AveragePrize    float64 // Estimativa conservadora do prêmio principal
+	StrategyNote    string  // Complemento na justificativa da estratégia (ex: "para maior frequência de ganhos")
+	PromptPriceNote string  // Observação após a tabela de preços no prompt da IA (ex: "(+ Mês da Sorte)")
+	PromptRules     string  // Regra de preenchimento obrigatória no prompt da IA
+	InMixedStrategy bool    // Se entra na estratégia mista ("Todas")
+}
+
+// Name retorna o nome de exibição da loteria
+func (d Definition) Name() string {
+	return d.Rules.Name
+}
+
+var registry = make(map[LotteryType]Definition)
+
+// Register adiciona uma loteria ao registro; chamado no init() do arquivo de cada loteria
+func Register(def Definition) {
+	if def.Type == "" {
+		panic("lottery: Register com tipo vazio")
+	}
+	if _, exists := registry[def.Type]; exists {
+		panic(fmt.Sprintf("lottery: %s registrada duas vezes", def.Type))
+	}
+	if def.Slug == "" {
+		def.Slug = string(def.Type)
+	}
+	if len(def.Prices) > 0 {
+		sortPrices(def.Prices)
+		def.Rules.BasePrice = def.Prices[len(def.Prices)-1].BasePrice
+	}
+	if def.Prize == nil && len(def.Rules.PrizeTiers) > 0 {
+		def.Prize = tieredPrize(def.Rules)
+	}
+	registry[def.Type] = def
+}
+
+// Get retorna a definição de uma loteria registrada
+func Get(ltype LotteryType) (Definition, bool) {
+	def, ok := registry[ltype]
+	return def, ok
+}
+
+// Lookup encontra uma loteria pelo tipo, slug, nome ou qualquer grafia alternativa registrada
+func Lookup(name string) (Definition, bool) {
+	if def, ok := registry[LotteryType(name)]; ok {
+		return def, true
+	}
+
+	key := foldLotteryName(name)
+	for _, def := range registry {
+		candidates := append([]string{string(def.Type), def.Slug, def.Rules.Name}, def.Aliases...)
+		for _, candidate := range candidates {
+			if foldLotteryName(candidate) == key {
+				return def, true
+			}
+		}
+	}
+
+	return Definition{}, false
+}
+
+// ParseLotteryType converte qualquer grafia aceita (ex: "mega-sena", "Mega-Sena") no identificador canônico
+// O identificador canônico é o LotteryType registrado, usado também na API da CAIXA e no banco de dados
+func ParseLotteryType(name string) (LotteryType, error) {
+	def, ok := Lookup(name)
+	if !ok {
+		return "", fmt.Errorf("tipo de loteria não suportado: %s", name)
+	}
+	return def.Type, nil
+}
+
+// All retorna todas as loterias registradas na ordem de exibição
+func All() []Definition {
+	defs := make([]Definition, 0, len(registry))
+	for _, def := range registry {
+		defs = append(defs, def)
+	}
+
+	sort.Slice(defs, func(i, j int) bool {
+		if defs[i].Order != defs[j].Order {
+			return defs[i].Order < defs[j].Order
+		}
+		return defs[i].Type < defs[j].Type
+	})
+	return defs
+}
+
+// Names retorna os nomes de exibição de todas as loterias registradas
+func Names() []string {
+	var names []string
+	for _, def := range All() {
+		names = append(names, def.Name())
+	}
+	return names
+}
+
+// APISlug retorna o caminho da loteria na API da CAIXA
+func APISlug(ltype LotteryType) string {
+	if def, ok := Get(ltype); ok {
+		return def.Slug
+	}
+	return string(ltype)
+}
+
+// foldLotteryName normaliza nomes para comparação ("Mega-Sena" == "megasena")
+func foldLotteryName(name string) string {
+	folded := accentReplacer.Replace(strings.ToUpper(strings.TrimSpace(name)))
+	return strings.NewReplacer(" ", "", "-", "", "_", "", "+", "MAIS").Replace(folded)
+}
@@ -0,0 +1,43 @@
+package lottery
+
+import "time"
+
+// SuperSete identifica a Super Sete
+const SuperSete LotteryType = "supersete"
+
+func init() {
+	Register(Definition{
+		Type:    SuperSete,
+		Aliases: []string{"super-sete"},
+		Emoji:   "🔢",
+		Order:   90,
+		// Super Sete: 7 colunas com dígitos de 0 a 9, de 7 a 21 marcações (até 3 por coluna)
+		Rules: LotteryRules{
+			Name:              "Super Sete",
+			MinNumbers:        7,
+			MaxNumbers:        21,
+			NumberRange:       10,
+			DrawDays:          []time.Weekday{time.Monday, time.Wednesday, time.Friday},
+			ResultNumbers:     7,
+			Columns:           7,
+			MaxMarksPerColumn: 3,
+			MinDigit:          0,
+			MaxDigit:          9,
+			PrizeTiers: []PrizeTier{
+				{Faixa: 1, Name: "7 colunas", Hits: 7},
+				{Faixa: 2, Name: "6 colunas", Hits: 6},
+				{Faixa: 3, Name: "5 colunas", Hits: 5},
+				{Faixa: 4, Name: "4 colunas", Hits: 4},
+				{Faixa: 5, Name: "3 colunas", Hits: 3},
+			},
+		},
+		Prices: []PriceChange{
+			{Effective: effective(2020, time.October, 3), BasePrice: 2.50},
+		},
+		SampleSize:      150, // ~1 ano de dados (3x por semana)
+		AveragePrize:    2000000.0,
+		StrategyNote:    "marcados coluna a coluna",
+		PromptRules:     "SUPER SETE: campo \"columns\" com 7 colunas de 1 a 3 dígitos entre 0 e 9 (ex: [[1],[5,7],[0],[9],[3],[2],[8]]) e \"numbers\" vazio",
+		InMixedStrategy: true,
+	})
+}
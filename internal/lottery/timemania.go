@@ -0,0 +1,45 @@
+package lottery
+
+import "time"
+
+// Timemania identifica a Timemania
+const Timemania LotteryType = "timemania"
+
+func init() {
+	Register(Definition{
+		Type:  Timemania,
+		Emoji: "⚽",
+		Order: 70,
+		// Timemania: 10 dezenas de 1 a 80 mais o Time do Coração
+		Rules: LotteryRules{
+			Name:          "Timemania",
+			MinNumbers:    10,
+			MaxNumbers:    10,
+			NumberRange:   80,
+			DrawDays:      []time.Weekday{time.Tuesday, time.Thursday, time.Saturday},
+			ResultNumbers: 7,
+			ExtraPickKind: ExtraPickTeam,
+			ExtraPickName: "Time do Coração",
+			// O Time do Coração é uma faixa à parte e soma com a das dezenas
+			PrizeTiers: []PrizeTier{
+				{Faixa: 1, Name: "7 acertos", Hits: 7},
+				{Faixa: 2, Name: "6 acertos", Hits: 6},
+				{Faixa: 3, Name: "5 acertos", Hits: 5},
+				{Faixa: 4, Name: "4 acertos", Hits: 4},
+				{Faixa: 5, Name: "3 acertos", Hits: 3},
+				{Faixa: 6, Name: "Time do Coração", ExtraPick: true},
+			},
+		},
+		// Timemania tem aposta única de 10 dezenas: C(10, 10) = 1 aposta simples
+		Prices: []PriceChange{
+			{Effective: effective(2023, time.April, 30), BasePrice: 3.50},
+		},
+		SampleSize:      150, // ~1 ano de dados (3x por semana)
+		AveragePrize:    3000000.0,
+		StrategyNote:    "com Time do Coração",
+		PromptPriceNote: "(+ Time do Coração)",
+		PromptRules:     "TIMEMANIA: EXATAMENTE 10 números entre 1 e 80 E o campo \"extraPick\" com o Time do Coração (ex: \"FLAMENGO/RJ\")",
+		// Fica fora da estratégia mista: o Time do Coração é uma escolha pessoal do apostador
+		InMixedStrategy: false,
+	})
+}
@@ -106,13 +106,23 @@ func (sis StringIntSlice) ToIntSlice() []int {
 }
 
 // LotteryType tipos de loteria suportados
+// Cada loteria declara sua constante e se registra no próprio arquivo (ver registry.go)
 type LotteryType string
 
+// ExtraPickKind tipo do palpite extra não numérico de algumas loterias
+type ExtraPickKind string
+
 const (
-	MegaSena  LotteryType = "megasena"
-	Lotofacil LotteryType = "lotofacil"
+	ExtraPickTeam  ExtraPickKind = "team"  // Time do Coração (Timemania)
+	ExtraPickMonth ExtraPickKind = "month" // Mês da Sorte (Dia de Sorte)
 )
 
+// Months nomes oficiais dos meses usados no Mês da Sorte
+var Months = []string{
+	"Janeiro", "Fevereiro", "Março", "Abril", "Maio", "Junho",
+	"Julho", "Agosto", "Setembro", "Outubro", "Novembro", "Dezembro",
+}
+
 // LotteryRules regras de cada tipo de loteria
 type LotteryRules struct {
 	Name          string
@@ -122,34 +132,68 @@ type LotteryRules struct {
 	BasePrice     float64
 	DrawDays      []time.Weekday
 	ResultNumbers int
+
+	// Concursos especiais com data fixa no ano (ex: Mega da Virada); datas que variam vêm da configuração
+	SpecialDraws []SpecialDraw
+
+	// Seleção secundária (ex: trevos da +Milionária). SecondaryRange zero indica que não existe
+	SecondaryName    string
+	SecondaryMin     int
+	SecondaryMax     int
+	SecondaryRange   int
+	SecondaryResults int
+
+	// Palpite extra não numérico (ex: Time do Coração, Mês da Sorte). Vazio indica que não existe
+	ExtraPickKind    ExtraPickKind
+	ExtraPickName    string
+	ExtraPickOptions []string // Opções válidas; vazio aceita qualquer valor (ex: clubes)
+
+	// Apostas posicionais (ex: Super Sete). Columns zero indica aposta em conjunto de dezenas
+	// Em apostas posicionais MinNumbers/MaxNumbers limitam o total de marcações
+	Columns           int
+	MaxMarksPerColumn int
+	MinDigit          int
+	MaxDigit          int
+
+	// Loterias de prognósticos esportivos (ex: Loteca). Matches zero indica loteria de dezenas
+	Matches int
+
+	DrawsPerContest int  // Sorteios independentes por concurso (2 na Dupla Sena); zero equivale a 1
+	MirrorBets      bool // Se aceita a aposta espelho (ex: Lotomania)
+
+	// Faixas de premiação, casadas com o payload da CAIXA pelo índice da faixa
+	PrizeTiers []PrizeTier
+}
+
+// HasMultipleDraws indica se cada concurso tem mais de um sorteio conferido separadamente
+func (r LotteryRules) HasMultipleDraws() bool {
+	return r.DrawsPerContest > 1
+}
+
+// IsMatchPool indica se a aposta é um palpite 1/X/2 por partida de futebol
+func (r LotteryRules) IsMatchPool() bool {
+	return r.Matches > 0
+}
+
+// IsPositional indica se a aposta é por colunas (cada coluna com seus próprios dígitos)
+func (r LotteryRules) IsPositional() bool {
+	return r.Columns > 0
 }
 
-// GetRules retorna as regras para cada tipo de loteria
+// HasExtraPick indica se a loteria exige um palpite extra não numérico
+func (r LotteryRules) HasExtraPick() bool {
+	return r.ExtraPickKind != ""
+}
+
+// HasSecondary indica se a loteria exige uma segunda seleção além das dezenas
+func (r LotteryRules) HasSecondary() bool {
+	return r.SecondaryRange > 0
+}
+
+// GetRules retorna as regras de uma loteria registrada (vazias se não registrada)
 func GetRules(ltype LotteryType) LotteryRules {
-	switch ltype {
-	case MegaSena:
-		return LotteryRules{
-			Name:          "Mega Sena",
-			MinNumbers:    6,
-			MaxNumbers:    20,
-			NumberRange:   60,
-			BasePrice:     5.00,
-			DrawDays:      []time.Weekday{time.Wednesday, time.Saturday},
-			ResultNumbers: 6,
-		}
-	case Lotofacil:
-		return LotteryRules{
-			Name:          "Lotofácil",
-			MinNumbers:    15,
-			MaxNumbers:    20,
-			NumberRange:   25,
-			BasePrice:     3.00,
-			DrawDays:      []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
-			ResultNumbers: 20,
-		}
-	default:
-		return LotteryRules{}
-	}
+	def, _ := Get(ltype)
+	return def.Rules
 }
 
 // Draw representa um sorteio individual
@@ -162,10 +206,51 @@ type Draw struct {
 	NextDrawNumber int            `json:"numeroConcursoProximo"`
 	NextDrawDate   BrazilianDate  `json:"dataProximoConcurso"`
 	Accumulated    bool           `json:"acumulado"`
+	SecondNumbers  StringIntSlice `json:"listaDezenasSegundoSorteio,omitempty"`    // Dupla Sena: dezenas do 2º sorteio
+	Secondary      StringIntSlice `json:"trevosSorteados,omitempty"`               // +Milionária: trevos sorteados
+	ExtraPick      string         `json:"nomeTimeCoracaoMesSorte,omitempty"`       // Timemania/Dia de Sorte: time ou mês sorteado
+	Matches        []LotecaMatch  `json:"listaResultadoEquipeEsportiva,omitempty"` // Loteca: partidas e placares
+
+	AccumulatedValue   float64          `json:"valorAcumuladoProximoConcurso"`        // Valor acumulado para a faixa principal do próximo concurso
+	EstimatedNextPrize float64          `json:"valorEstimadoProximoConcurso"`         // Prêmio estimado do próximo concurso
+	SpecialDrawValue   float64          `json:"valorAcumuladoConcursoEspecial"`       // Acumulado para o sorteio especial (ex: Mega da Virada)
+	Ending05Value      float64          `json:"valorAcumuladoConcurso_0_5"`           // Acumulado para o próximo concurso final 0 ou 5
+	Ending05Number     int              `json:"numeroConcursoFinal_0_5"`              // Número do próximo concurso final 0 ou 5
+	SpecialDraw        int              `json:"indicadorConcursoEspecial"`            // 1 = concurso regular, 2 = concurso especial
+	Location           string           `json:"localSorteio"`                         // Local do sorteio (ex: ESPAÇO DA SORTE)
+	LocationCity       string           `json:"nomeMunicipioUFSorteio"`               // Cidade/UF do sorteio
+	WinnerLocations    []WinnerLocation `json:"listaMunicipioUFGanhadores,omitempty"` // Cidades dos ganhadores da faixa principal
+
+	Manual bool `json:"manual,omitempty"` // Resultado informado pelo usuário; substituído pelo oficial quando a API responder
+}
+
+// WinnerLocation representa a cidade de um ou mais ganhadores da faixa principal
+type WinnerLocation struct {
+	Winners  int    `json:"ganhadores"`
+	City     string `json:"municipio"`
+	State    string `json:"uf"`
+	Position int    `json:"posicao"`
+	Outlet   string `json:"nomeFatansiaUL"` // Lotérica ou canal eletrônico (grafia da API da CAIXA)
+	Series   string `json:"serie"`
+}
+
+// IsSpecialDraw indica se o concurso é um sorteio especial (ex: Mega da Virada)
+func (d Draw) IsSpecialDraw() bool {
+	return d.SpecialDraw == 2
+}
+
+// DrawnSets retorna as dezenas de cada sorteio do concurso (dois na Dupla Sena, um nas demais)
+func (d Draw) DrawnSets() [][]int {
+	sets := [][]int{d.Numbers.ToIntSlice()}
+	if len(d.SecondNumbers) > 0 {
+		sets = append(sets, d.SecondNumbers.ToIntSlice())
+	}
+	return sets
 }
 
 // Winner representa ganhadores por faixa de prêmio
 type Winner struct {
+	Tier        int     `json:"faixa"` // Índice da faixa (1 = principal); a descrição em texto muda sem aviso
 	Description string  `json:"descricaoFaixa"`
 	Winners     int     `json:"numeroDeGanhadores"`
 	Prize       float64 `json:"valorPremio"`
@@ -175,6 +260,10 @@ type Winner struct {
 type Game struct {
 	Type           LotteryType `json:"type"`
 	Numbers        []int       `json:"numbers"`
+	Secondary      []int       `json:"secondary,omitempty"` // Seleção secundária (ex: trevos da +Milionária)
+	ExtraPick      string      `json:"extraPick,omitempty"` // Palpite extra (ex: Time do Coração, Mês da Sorte)
+	Columns        [][]int     `json:"columns,omitempty"`   // Aposta posicional: dígitos marcados em cada coluna (ex: Super Sete)
+	Picks          []string    `json:"picks,omitempty"`     // Loteca: palpite por partida ("1", "X", "2", "1X", "1X2"...)
 	Cost           float64     `json:"cost"`
 	ExpectedReturn float64     `json:"expectedReturn"`
 	Probability    float64     `json:"probability"`
@@ -212,6 +301,12 @@ type UserPreferences struct {
 	AvoidPatterns   bool          `json:"avoidPatterns"`
 	FavoriteNumbers []int         `json:"favoriteNumbers"`
 	ExcludeNumbers  []int         `json:"excludeNumbers"`
+
+	// Palpites extras preferidos por loteria (ex: Time do Coração na Timemania)
+	ExtraPicks map[LotteryType]string `json:"extraPicks,omitempty"`
+
+	// Prêmio estimado do próximo concurso informado pela CAIXA, usado no retorno esperado dos jogos
+	PrizeEstimates map[LotteryType]float64 `json:"prizeEstimates,omitempty"`
 }
 
 // AnalysisRequest requisição para análise da IA
@@ -233,6 +328,25 @@ type AnalysisResponse struct {
 func ValidateGame(game Game) error {
 	rules := GetRules(game.Type)
 
+	if rules.IsPositional() {
+		return validatePositionalGame(game, rules)
+	}
+	if len(game.Columns) > 0 {
+		return fmt.Errorf("%s não aceita aposta por colunas", rules.Name)
+	}
+
+	if rules.IsMatchPool() {
+		return validateMatchPoolGame(game, rules)
+	}
+	if len(game.Picks) > 0 {
+		return fmt.Errorf("%s não aceita palpites por partida", rules.Name)
+	}
+
+	if rules.MinNumbers == rules.MaxNumbers && len(game.Numbers) != rules.MinNumbers {
+		return fmt.Errorf("número de dezenas inválido para %s: deve ter exatamente %d",
+			rules.Name, rules.MinNumbers)
+	}
+
 	if len(game.Numbers) < rules.MinNumbers || len(game.Numbers) > rules.MaxNumbers {
 		return fmt.Errorf("número de dezenas inválido para %s: deve estar entre %d e %d",
 			rules.Name, rules.MinNumbers, rules.MaxNumbers)
@@ -254,67 +368,250 @@ func ValidateGame(game Game) error {
 		seen[num] = true
 	}
 
+	if rules.HasExtraPick() {
+		if _, err := NormalizeExtraPick(game.Type, game.ExtraPick); err != nil {
+			return err
+		}
+	} else if game.ExtraPick != "" {
+		return fmt.Errorf("%s não aceita palpite extra", rules.Name)
+	}
+
+	if !rules.HasSecondary() {
+		if len(game.Secondary) > 0 {
+			return fmt.Errorf("%s não aceita seleção secundária", rules.Name)
+		}
+		return nil
+	}
+
+	if len(game.Secondary) < rules.SecondaryMin || len(game.Secondary) > rules.SecondaryMax {
+		return fmt.Errorf("número de %s inválido para %s: deve estar entre %d e %d",
+			rules.SecondaryName, rules.Name, rules.SecondaryMin, rules.SecondaryMax)
+	}
+
+	seenSecondary := make(map[int]bool)
+	for _, num := range game.Secondary {
+		if num < 1 || num > rules.SecondaryRange {
+			return fmt.Errorf("%s %d inválido para %s: deve estar entre 1 e %d",
+				rules.SecondaryName, num, rules.Name, rules.SecondaryRange)
+		}
+		if seenSecondary[num] {
+			return fmt.Errorf("%s %d repetido no jogo", rules.SecondaryName, num)
+		}
+		seenSecondary[num] = true
+	}
+
+	return nil
+}
+
+// validatePositionalGame valida apostas por colunas, onde cada coluna tem seus próprios dígitos
+func validatePositionalGame(game Game, rules LotteryRules) error {
+	if len(game.Numbers) > 0 {
+		return fmt.Errorf("%s usa apostas por colunas, não uma lista de dezenas", rules.Name)
+	}
+
+	if len(game.Columns) != rules.Columns {
+		return fmt.Errorf("número de colunas inválido para %s: deve ter exatamente %d", rules.Name, rules.Columns)
+	}
+
+	totalMarks := 0
+	for i, column := range game.Columns {
+		if len(column) < 1 || len(column) > rules.MaxMarksPerColumn {
+			return fmt.Errorf("coluna %d inválida para %s: deve ter entre 1 e %d números",
+				i+1, rules.Name, rules.MaxMarksPerColumn)
+		}
+
+		seen := make(map[int]bool)
+		for _, digit := range column {
+			if digit < rules.MinDigit || digit > rules.MaxDigit {
+				return fmt.Errorf("número %d inválido na coluna %d: deve estar entre %d e %d",
+					digit, i+1, rules.MinDigit, rules.MaxDigit)
+			}
+			if seen[digit] {
+				return fmt.Errorf("número %d repetido na coluna %d", digit, i+1)
+			}
+			seen[digit] = true
+		}
+
+		totalMarks += len(column)
+	}
+
+	if totalMarks < rules.MinNumbers || totalMarks > rules.MaxNumbers {
+		return fmt.Errorf("total de marcações inválido para %s: deve estar entre %d e %d",
+			rules.Name, rules.MinNumbers, rules.MaxNumbers)
+	}
+
 	return nil
 }
 
-// CalculateGameCost calcula o custo de um jogo baseado na quantidade de números
-func CalculateGameCost(ltype LotteryType, numCount int) float64 {
-	switch ltype {
-	case MegaSena:
-		// Valores oficiais da CAIXA para Mega-Sena
-		switch numCount {
-		case 6:
-			return 5.00
-		case 7:
-			return 35.00
-		case 8:
-			return 140.00
-		case 9:
-			return 420.00
-		case 10:
-			return 1050.00
-		case 11:
-			return 2310.00
-		case 12:
-			return 4620.00
-		case 13:
-			return 8580.00
-		case 14:
-			return 15015.00
-		case 15:
-			return 25025.00
-		case 16:
-			return 40040.00
-		case 17:
-			return 61880.00
-		case 18:
-			return 92820.00
-		case 19:
-			return 135660.00
-		case 20:
-			return 193800.00
-		default:
-			return 5.00 // Fallback para jogo mínimo
+// CountMarks retorna o total de marcações de uma aposta por colunas
+func CountMarks(columns [][]int) int {
+	total := 0
+	for _, column := range columns {
+		total += len(column)
+	}
+	return total
+}
+
+// NormalizeExtraPick valida o palpite extra e retorna sua forma canônica
+// Para o Mês da Sorte aceita o nome ("agosto") ou o número ("8") do mês
+func NormalizeExtraPick(ltype LotteryType, value string) (string, error) {
+	rules := GetRules(ltype)
+	value = cleanExtraPick(value)
+
+	if value == "" {
+		return "", fmt.Errorf("%s é obrigatório para %s", rules.ExtraPickName, rules.Name)
+	}
+
+	if rules.ExtraPickKind == ExtraPickMonth {
+		if month, err := strconv.Atoi(value); err == nil && month >= 1 && month <= len(Months) {
+			return Months[month-1], nil
+		}
+	}
+
+	if len(rules.ExtraPickOptions) == 0 {
+		return strings.ToUpper(value), nil
+	}
+
+	for _, option := range rules.ExtraPickOptions {
+		if foldExtraPick(option) == foldExtraPick(value) {
+			return option, nil
+		}
+	}
+
+	return "", fmt.Errorf("%s inválido para %s: %s", rules.ExtraPickName, rules.Name, value)
+}
+
+// MatchExtraPick compara o palpite do jogo com o sorteado, ignorando acentos e caixa
+// Clubes são comparados pelo nome mesmo quando só um dos lados traz a UF ("SANTOS/SP")
+func MatchExtraPick(picked, drawn string) bool {
+	picked = foldExtraPick(picked)
+	drawn = foldExtraPick(drawn)
+	if picked == "" || drawn == "" {
+		return false
+	}
+	if picked == drawn {
+		return true
+	}
+
+	pickedName := strings.TrimSpace(strings.SplitN(picked, "/", 2)[0])
+	drawnName := strings.TrimSpace(strings.SplitN(drawn, "/", 2)[0])
+	return pickedName == drawnName && (!strings.Contains(picked, "/") || !strings.Contains(drawn, "/"))
+}
+
+// cleanExtraPick remove espaços e caracteres nulos que a API da CAIXA às vezes envia
+func cleanExtraPick(value string) string {
+	return strings.TrimSpace(strings.Trim(value, "\x00"))
+}
+
+var accentReplacer = strings.NewReplacer(
+	"Á", "A", "À", "A", "Â", "A", "Ã", "A",
+	"É", "E", "Ê", "E", "Í", "I", "Ó", "O",
+	"Ô", "O", "Õ", "O", "Ú", "U", "Ü", "U", "Ç", "C",
+)
+
+func foldExtraPick(value string) string {
+	return accentReplacer.Replace(strings.ToUpper(cleanExtraPick(value)))
+}
+
+// MirrorNumbers retorna a aposta espelho: todas as dezenas do volante que não estão no jogo
+func MirrorNumbers(numbers []int, numberRange int) []int {
+	chosen := make(map[int]bool, len(numbers))
+	for _, num := range numbers {
+		chosen[num] = true
+	}
+
+	mirror := make([]int, 0, numberRange-len(numbers))
+	for num := 1; num <= numberRange; num++ {
+		if !chosen[num] {
+			mirror = append(mirror, num)
 		}
-	case Lotofacil:
-		// Valores oficiais da CAIXA para Lotofácil
-		switch numCount {
-		case 15:
-			return 3.00
-		case 16:
-			return 48.00
-		case 17:
-			return 408.00
-		case 18:
-			return 2448.00
-		case 19:
-			return 11628.00
-		case 20:
-			return 46512.00
-		default:
-			return 3.00 // Fallback para jogo mínimo
+	}
+
+	return mirror
+}
+
+// Normalize ajusta o sorteio recebido da API para a representação interna da loteria
+func (d *Draw) Normalize(ltype LotteryType) {
+	// A API envia "nomeTimeCoracaoMesSorte" preenchido com caracteres nulos nas demais loterias
+	if GetRules(ltype).HasExtraPick() {
+		d.ExtraPick = cleanExtraPick(d.ExtraPick)
+	} else {
+		d.ExtraPick = ""
+	}
+
+	// A grade da Loteca é conferida pela ordem das partidas
+	if len(d.Matches) > 0 {
+		sortMatches(d.Matches)
+	}
+
+	if def, ok := Get(ltype); ok && def.NormalizeDraw != nil {
+		def.NormalizeDraw(d)
+	}
+}
+
+// GameCost calcula o custo de um jogo com o preço atual, considerando também a seleção secundária
+func GameCost(game Game) (float64, error) {
+	return GameCostOn(game, time.Time{})
+}
+
+// CalculatePositionalGameCost calcula o custo de apostas por colunas com o preço atual
+// Cada combinação de um dígito por coluna é uma aposta simples: custo = produto das marcações x preço base
+func CalculatePositionalGameCost(ltype LotteryType, columns [][]int) float64 {
+	return float64(positionalSimpleBets(columns)) * GetRules(ltype).BasePrice
+}
+
+// positionalSimpleBets conta as apostas simples de uma aposta por colunas
+func positionalSimpleBets(columns [][]int) int64 {
+	simpleBets := int64(1)
+	for _, column := range columns {
+		if len(column) > 0 {
+			simpleBets *= int64(len(column))
 		}
-	default:
+	}
+	return simpleBets
+}
+
+// CalculateSecondaryGameCost calcula o custo de loterias com duas matrizes (ex: dezenas x trevos) com o preço atual
+// Cada aposta múltipla equivale a C(dezenas, mínimo) x C(trevos, mínimo) apostas simples
+func CalculateSecondaryGameCost(ltype LotteryType, numCount, secondaryCount int) (float64, error) {
+	simpleBets, err := SimpleBets(ltype, numCount, secondaryCount)
+	if err != nil {
+		return 0, err
+	}
+	return float64(simpleBets) * GetRules(ltype).BasePrice, nil
+}
+
+// secondarySimpleBets conta as apostas simples de um jogo com seleção secundária
+func secondarySimpleBets(rules LotteryRules, numCount, secondaryCount int) (int64, error) {
+	if numCount < rules.MinNumbers || numCount > rules.MaxNumbers {
+		return 0, fmt.Errorf("número de dezenas inválido para %s: %d (deve estar entre %d e %d)",
+			rules.Name, numCount, rules.MinNumbers, rules.MaxNumbers)
+	}
+	if secondaryCount < rules.SecondaryMin || secondaryCount > rules.SecondaryMax {
+		return 0, fmt.Errorf("número de %s inválido para %s: %d (deve estar entre %d e %d)",
+			rules.SecondaryName, rules.Name, secondaryCount, rules.SecondaryMin, rules.SecondaryMax)
+	}
+	return Combinations(numCount, rules.MinNumbers) * Combinations(secondaryCount, rules.SecondaryMin), nil
+}
+
+// Combinations calcula C(n, k)
+func Combinations(n, k int) int64 {
+	if k < 0 || k > n {
 		return 0
 	}
+	if k > n-k {
+		k = n - k
+	}
+
+	result := int64(1)
+	for i := 0; i < k; i++ {
+		result = result * int64(n-i) / int64(i+1)
+	}
+	return result
+}
+
+// CalculateGameCost calcula o custo de um jogo baseado na quantidade de números com o preço atual
+// O custo é C(dezenas, mínimo) apostas simples x preço base (ver pricing.go)
+func CalculateGameCost(ltype LotteryType, numCount int) (float64, error) {
+	return CalculateGameCostOn(ltype, numCount, time.Time{})
 }
@@ -0,0 +1,341 @@
+package mockcaixa
+
+import (
+	"fmt"
+	"hash/fnv"
+	"lottery-optimizer-gui/internal/lottery"
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+)
+
+// drawHour horário dos sorteios (20h de Brasília); o resultado só é publicado a partir dele
+const drawHour = 20
+
+// brasilia fuso dos sorteios; o Brasil não tem horário de verão desde 2019
+var brasilia = time.FixedZone("BRT", -3*60*60)
+
+// maxAccumulationStreak limita quantos concursos para trás são olhados para calcular o acumulado
+const maxAccumulationStreak = 30
+
+// clubs times usados no Time do Coração e nas partidas da Loteca
+var clubs = []string{
+	"FLAMENGO/RJ", "PALMEIRAS/SP", "CORINTHIANS/SP", "SÃO PAULO/SP", "SANTOS/SP", "GRÊMIO/RS",
+	"INTERNACIONAL/RS", "ATLÉTICO/MG", "CRUZEIRO/MG", "VASCO/RJ", "FLUMINENSE/RJ", "BOTAFOGO/RJ",
+	"BAHIA/BA", "VITÓRIA/BA", "SPORT/PE", "NÁUTICO/PE", "CEARÁ/CE", "FORTALEZA/CE",
+	"ATHLETICO/PR", "CORITIBA/PR", "GOIÁS/GO", "PAYSANDU/PA", "REMO/PA", "AVAÍ/SC",
+}
+
+// cities cidades usadas na lista de ganhadores
+var cities = []struct{ City, State string }{
+	{"SÃO PAULO", "SP"}, {"RIO DE JANEIRO", "RJ"}, {"BELO HORIZONTE", "MG"}, {"CURITIBA", "PR"},
+	{"PORTO ALEGRE", "RS"}, {"SALVADOR", "BA"}, {"RECIFE", "PE"}, {"FORTALEZA", "CE"},
+	{"GOIÂNIA", "GO"}, {"BELÉM", "PA"}, {"CAMPINAS", "SP"}, {"FLORIANÓPOLIS", "SC"},
+}
+
+// Schedule define quando cada concurso sintético é sorteado
+type Schedule struct {
+	// Start é a data do concurso 1
+	Start time.Time
+	// Interval, se maior que zero, sorteia um concurso a cada intervalo a partir de Start
+	// (útil para ver resultados e notificações chegando durante o teste). Zero usa os dias
+	// de sorteio de cada loteria (LotteryRules.DrawDays)
+	Interval time.Duration
+}
+
+// Generator gera sorteios sintéticos determinísticos: a mesma semente sempre produz os mesmos concursos
+type Generator struct {
+	Seed             int64
+	Schedule         Schedule
+	AccumulationRate float64 // Chance de a faixa principal não ter ganhadores (0 a 1)
+}
+
+// weekOffsets retorna os dias, contados a partir de Start, em que há sorteio na primeira semana
+func (g *Generator) weekOffsets(ltype lottery.LotteryType) []int {
+	drawDays := lottery.GetRules(ltype).DrawDays
+	if len(drawDays) == 0 {
+		drawDays = []time.Weekday{time.Saturday}
+	}
+
+	var offsets []int
+	for offset := 0; offset < 7; offset++ {
+		weekday := g.Schedule.Start.In(brasilia).AddDate(0, 0, offset).Weekday()
+		for _, day := range drawDays {
+			if day == weekday {
+				offsets = append(offsets, offset)
+				break
+			}
+		}
+	}
+	return offsets
+}
+
+// ContestTime retorna o momento do sorteio do concurso
+func (g *Generator) ContestTime(ltype lottery.LotteryType, number int) time.Time {
+	start := g.Schedule.Start.In(brasilia)
+	if g.Schedule.Interval > 0 {
+		return start.Add(time.Duration(number-1) * g.Schedule.Interval)
+	}
+
+	offsets := g.weekOffsets(ltype)
+	week, index := (number-1)/len(offsets), (number-1)%len(offsets)
+	day := start.AddDate(0, 0, week*7+offsets[index])
+	return time.Date(day.Year(), day.Month(), day.Day(), drawHour, 0, 0, 0, brasilia)
+}
+
+// Latest retorna o último concurso já sorteado no momento informado (zero se nenhum)
+func (g *Generator) Latest(ltype lottery.LotteryType, now time.Time) int {
+	start := g.ContestTime(ltype, 1)
+	if now.Before(start) {
+		return 0
+	}
+
+	if g.Schedule.Interval > 0 {
+		return int(now.Sub(start)/g.Schedule.Interval) + 1
+	}
+
+	// Estimativa pela quantidade de semanas, ajustada nos concursos vizinhos
+	offsets := g.weekOffsets(ltype)
+	number := int(now.Sub(start).Hours()/24/7)*len(offsets) + 1
+	for number > 1 && g.ContestTime(ltype, number).After(now) {
+		number--
+	}
+	for !g.ContestTime(ltype, number+1).After(now) {
+		number++
+	}
+	return number
+}
+
+// rng retorna o gerador aleatório do concurso, derivado da semente, da loteria e do número
+func (g *Generator) rng(ltype lottery.LotteryType, number int) *rand.Rand {
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%d/%s/%d", g.Seed, ltype, number)
+	return rand.New(rand.NewSource(int64(h.Sum64())))
+}
+
+// accumulated indica se a faixa principal do concurso ficou sem ganhadores
+func (g *Generator) accumulated(ltype lottery.LotteryType, number int) bool {
+	if number < 1 {
+		return false
+	}
+	return g.rng(ltype, -number).Float64() < g.AccumulationRate
+}
+
+// streak conta os concursos seguidos acumulados até o informado (inclusive)
+func (g *Generator) streak(ltype lottery.LotteryType, number int) int {
+	streak := 0
+	for n := number; n > 0 && streak < maxAccumulationStreak && g.accumulated(ltype, n); n-- {
+		streak++
+	}
+	return streak
+}
+
+// mainPrize retorna o prêmio da faixa principal de um concurso que vem depois de streak acumulados
+func mainPrize(ltype lottery.LotteryType, streak int) float64 {
+	base := 1000000.0
+	if def, ok := lottery.Get(ltype); ok && def.AveragePrize > 0 {
+		base = def.AveragePrize
+	}
+	return math.Round(base * (1 + 0.6*float64(streak)))
+}
+
+// Payload gera o JSON do concurso no formato da API da CAIXA
+// Com fixturesOnly, gera só a grade de um concurso da Loteca ainda não apurado (sem placares)
+func (g *Generator) Payload(ltype lottery.LotteryType, number int, fixturesOnly bool) map[string]interface{} {
+	rules := lottery.GetRules(ltype)
+	rng := g.rng(ltype, number)
+	drawTime := g.ContestTime(ltype, number)
+	nextTime := g.ContestTime(ltype, number+1)
+
+	accumulated := g.accumulated(ltype, number)
+	prize := mainPrize(ltype, g.streak(ltype, number-1))
+	nextStreak := g.streak(ltype, number)
+	nextPrize := mainPrize(ltype, nextStreak)
+
+	payload := map[string]interface{}{
+		"numero":                         number,
+		"tipoJogo":                       lottery.APISlug(ltype),
+		"dataApuracao":                   drawTime.Format("02/01/2006"),
+		"numeroConcursoAnterior":         number - 1,
+		"numeroConcursoProximo":          number + 1,
+		"dataProximoConcurso":            nextTime.Format("02/01/2006"),
+		"acumulado":                      accumulated,
+		"valorArrecadado":                math.Round(prize * (2 + rng.Float64()*3)),
+		"valorEstimadoProximoConcurso":   nextPrize,
+		"valorAcumuladoProximoConcurso":  0.0,
+		"valorAcumuladoConcursoEspecial": math.Round(prize * 0.05 * float64(number%50+1)),
+		"indicadorConcursoEspecial":      1,
+		"localSorteio":                   "ESPAÇO DA SORTE",
+		"nomeMunicipioUFSorteio":         "SÃO PAULO, SP",
+		"listaRateioPremio":              []map[string]interface{}{},
+		"listaMunicipioUFGanhadores":     []map[string]interface{}{},
+	}
+	if accumulated {
+		payload["valorAcumuladoProximoConcurso"] = math.Round(nextPrize * 0.8)
+	}
+
+	if rules.IsMatchPool() {
+		payload["listaResultadoEquipeEsportiva"] = matches(rng, rules.Matches, drawTime, fixturesOnly)
+		if fixturesOnly {
+			payload["dataApuracao"] = nil
+			return payload
+		}
+	} else {
+		drawn := drawNumbers(rng, rules)
+		payload["dezenasSorteadasOrdemSorteio"] = formatNumbers(drawn, rules)
+		payload["listaDezenas"] = formatNumbers(sortedCopy(drawn, rules), rules)
+
+		if rules.HasMultipleDraws() {
+			second := drawNumbers(rng, rules)
+			payload["listaDezenasSegundoSorteio"] = formatNumbers(sortedCopy(second, rules), rules)
+		}
+		if rules.HasSecondary() {
+			trevos := rng.Perm(rules.SecondaryRange)[:rules.SecondaryResults]
+			var secondary []string
+			for _, t := range trevos {
+				secondary = append(secondary, fmt.Sprintf("%d", t+1))
+			}
+			payload["trevosSorteados"] = secondary
+		}
+	}
+
+	switch rules.ExtraPickKind {
+	case lottery.ExtraPickMonth:
+		payload["nomeTimeCoracaoMesSorte"] = lottery.Months[rng.Intn(len(lottery.Months))]
+	case lottery.ExtraPickTeam:
+		options := rules.ExtraPickOptions
+		if len(options) == 0 {
+			options = clubs
+		}
+		payload["nomeTimeCoracaoMesSorte"] = options[rng.Intn(len(options))]
+	default:
+		// A API real manda o campo preenchido com caracteres nulos nas demais loterias
+		payload["nomeTimeCoracaoMesSorte"] = "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00"
+	}
+
+	tiers, locations := prizeTiers(rng, rules, prize, accumulated)
+	payload["listaRateioPremio"] = tiers
+	payload["listaMunicipioUFGanhadores"] = locations
+	return payload
+}
+
+// drawNumbers sorteia as dezenas (ou os dígitos de cada coluna) na ordem do sorteio
+func drawNumbers(rng *rand.Rand, rules lottery.LotteryRules) []int {
+	if rules.IsPositional() {
+		digits := make([]int, rules.Columns)
+		for i := range digits {
+			digits[i] = rules.MinDigit + rng.Intn(rules.MaxDigit-rules.MinDigit+1)
+		}
+		return digits
+	}
+
+	perm := rng.Perm(rules.NumberRange)[:rules.ResultNumbers]
+	numbers := make([]int, len(perm))
+	for i, p := range perm {
+		numbers[i] = p + 1
+	}
+	return numbers
+}
+
+// sortedCopy ordena as dezenas como na "listaDezenas" da CAIXA; colunas mantêm a ordem
+func sortedCopy(numbers []int, rules lottery.LotteryRules) []int {
+	sorted := append([]int(nil), numbers...)
+	if !rules.IsPositional() {
+		sort.Ints(sorted)
+	}
+	return sorted
+}
+
+// formatNumbers formata as dezenas como a CAIXA ("05"); na Lotomania a dezena 100 sai como "00"
+func formatNumbers(numbers []int, rules lottery.LotteryRules) []string {
+	formatted := make([]string, len(numbers))
+	for i, num := range numbers {
+		switch {
+		case rules.IsPositional():
+			formatted[i] = fmt.Sprintf("%d", num)
+		case rules.NumberRange == 100:
+			formatted[i] = fmt.Sprintf("%02d", num%100)
+		default:
+			formatted[i] = fmt.Sprintf("%02d", num)
+		}
+	}
+	return formatted
+}
+
+// matches gera a grade da Loteca; sem placares enquanto a rodada não foi apurada
+func matches(rng *rand.Rand, count int, drawTime time.Time, fixturesOnly bool) []map[string]interface{} {
+	teams := rng.Perm(len(clubs))
+	gameDay := drawTime.AddDate(0, 0, -1)
+
+	list := make([]map[string]interface{}, count)
+	for i := range list {
+		match := map[string]interface{}{
+			"nuSequencial":    i + 1,
+			"nomeEquipeUm":    clubs[teams[(2*i)%len(clubs)]],
+			"nomeEquipeDois":  clubs[teams[(2*i+1)%len(clubs)]],
+			"nuGolEquipeUm":   nil,
+			"nuGolEquipeDois": nil,
+			"dtJogo":          gameDay.Format("02/01/2006"),
+			"diaSemana":       "Domingo",
+		}
+		if !fixturesOnly {
+			match["nuGolEquipeUm"] = rng.Intn(4)
+			match["nuGolEquipeDois"] = rng.Intn(4)
+		}
+		list[i] = match
+	}
+	return list
+}
+
+// prizeTiers gera o rateio das faixas e, se houver ganhadores na principal, as cidades deles
+func prizeTiers(rng *rand.Rand, rules lottery.LotteryRules, prize float64, accumulated bool) ([]map[string]interface{}, []map[string]interface{}) {
+	tiers := make([]map[string]interface{}, 0, len(rules.PrizeTiers))
+	var locations []map[string]interface{}
+
+	for i, tier := range rules.PrizeTiers {
+		winners := 0
+		value := 0.0
+
+		switch {
+		case i == 0 || (rules.HasMultipleDraws() && tier.Draw > 1 && tier.Hits == rules.PrizeTiers[0].Hits):
+			// Faixa principal (e a do 2º sorteio da Dupla Sena)
+			if !accumulated {
+				winners = 1 + rng.Intn(3)
+				value = math.Round(prize/float64(winners)*100) / 100
+			}
+		case tier.FixedPrize > 0:
+			winners = int(math.Pow(8, float64(i))) + rng.Intn(int(math.Pow(8, float64(i))))
+			value = tier.FixedPrize
+		default:
+			winners = int(math.Pow(5, float64(i))) + rng.Intn(int(math.Pow(5, float64(i)))+1)
+			value = math.Max(math.Round(prize/math.Pow(40, float64(i))*100)/100, 1)
+		}
+
+		tiers = append(tiers, map[string]interface{}{
+			"faixa":              tier.Faixa,
+			"descricaoFaixa":     tier.Name,
+			"numeroDeGanhadores": winners,
+			"valorPremio":        value,
+		})
+
+		if i == 0 {
+			for w := 0; w < winners; w++ {
+				city := cities[rng.Intn(len(cities))]
+				locations = append(locations, map[string]interface{}{
+					"ganhadores":     1,
+					"municipio":      city.City,
+					"uf":             city.State,
+					"posicao":        w + 1,
+					"nomeFatansiaUL": "CANAL ELETRONICO",
+					"serie":          "",
+				})
+			}
+		}
+	}
+
+	if locations == nil {
+		locations = []map[string]interface{}{}
+	}
+	return tiers, locations
+}
@@ -0,0 +1,83 @@
+package mockcaixa
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"lottery-optimizer-gui/internal/lottery"
+)
+
+// decodeDraw passa o payload pelo mesmo JSON que o cliente recebe da API
+func decodeDraw(t *testing.T, payload map[string]interface{}) lottery.Draw {
+	t.Helper()
+	body, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatalf("json.Marshal: %v", err)
+	}
+	var draw lottery.Draw
+	if err := json.Unmarshal(body, &draw); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+	return draw
+}
+
+func TestPayloadDrawSize(t *testing.T) {
+	generator := &Generator{
+		Seed:     42,
+		Schedule: Schedule{Start: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	// Tamanhos oficiais de cada sorteio, independentes das regras registradas
+	tests := []struct {
+		ltype     lottery.LotteryType
+		numbers   int
+		secondary int
+	}{
+		{lottery.MegaSena, 6, 0},
+		{lottery.Lotofacil, 15, 0},
+		{lottery.Quina, 5, 0},
+		{lottery.Lotomania, 20, 0},
+		{lottery.DuplaSena, 6, 0},
+		{lottery.MaisMilionaria, 6, 2},
+		{lottery.Timemania, 7, 0},
+		{lottery.DiaDeSorte, 7, 0},
+		{lottery.SuperSete, 7, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.ltype), func(t *testing.T) {
+			for number := 1; number <= 20; number++ {
+				draw := decodeDraw(t, generator.Payload(tt.ltype, number, false))
+				if len(draw.Numbers) != tt.numbers {
+					t.Fatalf("concurso %d: %d dezenas, want %d", number, len(draw.Numbers), tt.numbers)
+				}
+				if lottery.GetRules(tt.ltype).HasMultipleDraws() && len(draw.SecondNumbers) != tt.numbers {
+					t.Fatalf("concurso %d: %d dezenas no 2º sorteio, want %d", number, len(draw.SecondNumbers), tt.numbers)
+				}
+				if len(draw.Secondary) != tt.secondary {
+					t.Fatalf("concurso %d: %d trevos, want %d", number, len(draw.Secondary), tt.secondary)
+				}
+			}
+		})
+	}
+}
+
+func TestPayloadDeterministic(t *testing.T) {
+	start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	first := &Generator{Seed: 7, Schedule: Schedule{Start: start}}
+	second := &Generator{Seed: 7, Schedule: Schedule{Start: start}}
+	other := &Generator{Seed: 8, Schedule: Schedule{Start: start}}
+
+	a := decodeDraw(t, first.Payload(lottery.Lotofacil, 10, false))
+	b := decodeDraw(t, second.Payload(lottery.Lotofacil, 10, false))
+	if !reflect.DeepEqual(a.Numbers, b.Numbers) {
+		t.Errorf("mesma semente gerou %v e %v", a.Numbers, b.Numbers)
+	}
+
+	c := decodeDraw(t, other.Payload(lottery.Lotofacil, 10, false))
+	if reflect.DeepEqual(a.Numbers, c.Numbers) {
+		t.Errorf("sementes diferentes geraram as mesmas dezenas %v", a.Numbers)
+	}
+}
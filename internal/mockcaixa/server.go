@@ -0,0 +1,135 @@
+package mockcaixa
+
+import (
+	"encoding/json"
+	"lottery-optimizer-gui/internal/lottery"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// apiPrefix caminho da API real da CAIXA; aceito para a URL base poder ser trocada só no host
+const apiPrefix = "/portaldeloterias/api"
+
+// Faults configura as falhas injetadas nas respostas
+type Faults struct {
+	BlockRate       float64       // Chance de responder 403, como o bloqueio da CAIXA (0 a 1)
+	ServerErrorRate float64       // Chance de responder 500/502/503 (0 a 1)
+	Latency         time.Duration // Atraso fixo antes de cada resposta
+}
+
+// Server serve sorteios sintéticos no formato da API da CAIXA para todas as loterias registradas
+//
+//	GET /{slug}/          último concurso já sorteado
+//	GET /{slug}/{numero}  concurso específico (404 se ainda não foi sorteado)
+//
+// Na Loteca, o concurso seguinte ao último é servido sem placares, como a grade publicada antes da rodada
+type Server struct {
+	Generator *Generator
+	Faults    Faults
+	Now       func() time.Time             // Relógio usado para decidir o último concurso (padrão: time.Now)
+	Logf      func(string, ...interface{}) // Log de cada requisição (opcional)
+
+	mu     sync.Mutex
+	faults *rand.Rand // Sorteio das falhas, separado da semente dos concursos
+	slugs  map[string]lottery.LotteryType
+}
+
+// NewServer cria o servidor com o gerador e as falhas informados
+func NewServer(generator *Generator, faults Faults) *Server {
+	slugs := make(map[string]lottery.LotteryType)
+	for _, def := range lottery.All() {
+		slugs[def.Slug] = def.Type
+	}
+
+	return &Server{
+		Generator: generator,
+		Faults:    faults,
+		Now:       time.Now,
+		faults:    rand.New(rand.NewSource(time.Now().UnixNano())),
+		slugs:     slugs,
+	}
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	status, body := s.respond(r)
+
+	if s.Logf != nil {
+		s.Logf("%s %s -> %d", r.Method, r.URL.Path, status)
+	}
+
+	if body == nil {
+		http.Error(w, http.StatusText(status), status)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+// respond resolve a requisição: status e corpo JSON (nil para respostas de erro)
+func (s *Server) respond(r *http.Request) (int, interface{}) {
+	if s.Faults.Latency > 0 {
+		time.Sleep(s.Faults.Latency)
+	}
+	if status := s.injectFault(); status != 0 {
+		return status, nil
+	}
+
+	if r.Method != http.MethodGet {
+		return http.StatusMethodNotAllowed, nil
+	}
+
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, apiPrefix), "/")
+	parts := strings.Split(path, "/")
+	if len(parts) > 2 {
+		return http.StatusNotFound, nil
+	}
+
+	ltype, ok := s.slugs[parts[0]]
+	if !ok {
+		return http.StatusNotFound, nil
+	}
+
+	latest := s.Generator.Latest(ltype, s.Now())
+	number := latest
+	if len(parts) == 2 && parts[1] != "" {
+		n, err := strconv.Atoi(parts[1])
+		if err != nil || n < 1 {
+			return http.StatusNotFound, nil
+		}
+		number = n
+	}
+
+	switch {
+	case number >= 1 && number <= latest:
+		return http.StatusOK, s.Generator.Payload(ltype, number, false)
+	case number == latest+1 && lottery.GetRules(ltype).IsMatchPool():
+		return http.StatusOK, s.Generator.Payload(ltype, number, true)
+	default:
+		return http.StatusNotFound, nil
+	}
+}
+
+// injectFault sorteia uma falha conforme as taxas configuradas; zero se a requisição segue normal
+func (s *Server) injectFault() int {
+	if s.Faults.BlockRate <= 0 && s.Faults.ServerErrorRate <= 0 {
+		return 0
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	roll := s.faults.Float64()
+	switch {
+	case roll < s.Faults.BlockRate:
+		return http.StatusForbidden
+	case roll < s.Faults.BlockRate+s.Faults.ServerErrorRate:
+		return []int{http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable}[s.faults.Intn(3)]
+	default:
+		return 0
+	}
+}
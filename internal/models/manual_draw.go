@@ -0,0 +1,21 @@
+package models
+
+// ManualDrawRequest é um resultado informado pelo usuário quando a API da CAIXA está fora do ar
+// Fica guardado como manual e é substituído pelo oficial assim que a API responder
+type ManualDrawRequest struct {
+	LotteryType   string        `json:"lottery_type"`
+	ContestNumber int           `json:"contest_number"`
+	DrawDate      string        `json:"draw_date"`                // Data do sorteio (DD/MM/AAAA)
+	Numbers       []int         `json:"numbers"`                  // Dezenas sorteadas (na ordem das colunas no Super Sete)
+	SecondNumbers []int         `json:"second_numbers,omitempty"` // Dupla Sena: dezenas do 2º sorteio
+	Secondary     []int         `json:"secondary,omitempty"`      // +Milionária: trevos sorteados
+	ExtraPick     string        `json:"extra_pick,omitempty"`     // Timemania/Dia de Sorte: time ou mês sorteado
+	Prizes        []ManualPrize `json:"prizes,omitempty"`         // Rateio por faixa, se já divulgado
+}
+
+// ManualPrize é o rateio de uma faixa informado pelo usuário
+type ManualPrize struct {
+	Tier    int     `json:"tier"` // Índice da faixa (1 = principal)
+	Winners int     `json:"winners"`
+	Prize   float64 `json:"prize"` // Valor pago a cada ganhador
+}
@@ -0,0 +1,77 @@
+package models
+
+import "time"
+
+// Tipos de lançamento no extrato do bolão
+const (
+	LedgerPayment = "payment" // Pagamento das cotas pelo participante
+	LedgerPrize   = "prize"   // Parte do prêmio creditada ao participante
+	LedgerPayout  = "payout"  // Repasse do prêmio do organizador ao participante
+)
+
+// Pool representa um bolão: jogos salvos pagos em conjunto e divididos em cotas
+type Pool struct {
+	ID           string    `json:"id" db:"id"`
+	Name         string    `json:"name" db:"name"`
+	Organizer    string    `json:"organizer" db:"organizer"`
+	OrganizerFee float64   `json:"organizer_fee" db:"organizer_fee"` // Taxa do organizador em % sobre o custo dos jogos
+	Status       string    `json:"status" db:"status"`               // "open", "closed"
+	CreatedAt    time.Time `json:"created_at" db:"created_at"`
+
+	Participants []PoolParticipant `json:"participants,omitempty"`
+	Games        []SavedGame       `json:"games,omitempty"`
+	Summary      *PoolSummary      `json:"summary,omitempty"` // Totais do bolão (não armazenado no DB)
+}
+
+// PoolParticipant representa um participante do bolão e suas cotas
+type PoolParticipant struct {
+	ID        string    `json:"id" db:"id"`
+	PoolID    string    `json:"pool_id" db:"pool_id"`
+	Name      string    `json:"name" db:"name"`
+	Quotas    int       `json:"quotas" db:"quotas"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
+
+// PoolLedgerEntry representa um lançamento no extrato de um participante
+type PoolLedgerEntry struct {
+	ID            string    `json:"id" db:"id"`
+	PoolID        string    `json:"pool_id" db:"pool_id"`
+	ParticipantID string    `json:"participant_id" db:"participant_id"`
+	GameID        string    `json:"game_id,omitempty" db:"game_id"` // Jogo premiado (lançamentos de prêmio)
+	Kind          string    `json:"kind" db:"kind"`                 // LedgerPayment, LedgerPrize ou LedgerPayout
+	Amount        float64   `json:"amount" db:"amount"`
+	Description   string    `json:"description" db:"description"`
+	CreatedAt     time.Time `json:"created_at" db:"created_at"`
+}
+
+// PoolSummary totaliza custos, cotas e prêmios de um bolão
+type PoolSummary struct {
+	TotalQuotas    int     `json:"total_quotas"`
+	GamesCost      float64 `json:"games_cost"`      // Soma do custo dos jogos
+	OrganizerFee   float64 `json:"organizer_fee"`   // Valor da taxa do organizador
+	TotalCost      float64 `json:"total_cost"`      // Custo dos jogos + taxa
+	QuotaPrice     float64 `json:"quota_price"`     // Valor de cada cota
+	TotalCollected float64 `json:"total_collected"` // Pagamentos recebidos
+	TotalPrize     float64 `json:"total_prize"`     // Prêmios creditados aos participantes
+	TotalPaidOut   float64 `json:"total_paid_out"`  // Prêmios já repassados
+}
+
+// PoolStatement representa o extrato e o saldo de um participante do bolão
+// Saldo positivo é o que o bolão deve ao participante; negativo é o que falta ele pagar
+type PoolStatement struct {
+	Participant PoolParticipant   `json:"participant"`
+	Share       float64           `json:"share"`      // Fração do bolão (cotas / total de cotas)
+	AmountDue   float64           `json:"amount_due"` // Valor das cotas do participante
+	Paid        float64           `json:"paid"`
+	Prizes      float64           `json:"prizes"`
+	PaidOut     float64           `json:"paid_out"`
+	Balance     float64           `json:"balance"` // Paid - AmountDue + Prizes - PaidOut
+	Entries     []PoolLedgerEntry `json:"entries"`
+}
+
+// CreatePoolRequest representa a requisição para criar um bolão
+type CreatePoolRequest struct {
+	Name         string  `json:"name"`
+	Organizer    string  `json:"organizer"`
+	OrganizerFee float64 `json:"organizer_fee"` // Em % sobre o custo dos jogos (ex: 10 = 10%)
+}
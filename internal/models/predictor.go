@@ -11,6 +11,7 @@ type ConcursoData struct {
 	Premios        []PremioFaixa `json:"premios"`
 	Acumulado      bool          `json:"acumulado"`
 	ValorAcumulado float64       `json:"valor_acumulado"`
+	PremioEstimado float64       `json:"premio_estimado"` // Prêmio estimado do concurso seguinte
 	IntervaloDias  int           `json:"intervalo_dias"`
 	LotteryType    string        `json:"lottery_type"`
 	TemporalWeight float64       `json:"temporal_weight"` // Peso temporal para análise ponderada
@@ -18,6 +19,7 @@ type ConcursoData struct {
 
 // PremioFaixa representa informações de prêmio por faixa
 type PremioFaixa struct {
+	Indice     int     `json:"indice"` // Índice da faixa na API (1 = principal)
 	Faixa      string  `json:"faixa"`
 	Ganhadores int     `json:"ganhadores"`
 	Valor      float64 `json:"valor"`
@@ -49,6 +51,7 @@ type CycleInfo struct {
 type AccumInfo struct {
 	ConsecutiveAccumulations int     `json:"consecutiveAccumulations"`
 	CurrentAccumulatedValue  float64 `json:"currentAccumulatedValue"`
+	EstimatedNextPrize       float64 `json:"estimatedNextPrize"`
 	AverageBeforeExplosion   int     `json:"averageBeforeExplosion"`
 	ExplosionProbability     float64 `json:"explosionProbability"`
 }
@@ -0,0 +1,29 @@
+package models
+
+import "time"
+
+// RecurringBet representa uma Teimosinha: a mesma aposta repetida em concursos consecutivos
+// Cada concurso vira um jogo salvo pendente; a sequência inteira é paga na compra
+type RecurringBet struct {
+	ID           string    `json:"id" db:"id"`
+	LotteryType  string    `json:"lottery_type" db:"lottery_type"`
+	FirstContest int       `json:"first_contest" db:"first_contest"` // Primeiro concurso da sequência
+	Contests     int       `json:"contests" db:"contests"`           // Quantidade de concursos consecutivos
+	TotalCost    float64   `json:"total_cost" db:"total_cost"`       // Custo da sequência inteira, pelo preço da compra
+	CreatedAt    time.Time `json:"created_at" db:"created_at"`
+
+	Games  []SavedGame      `json:"games,omitempty"`  // Um jogo salvo por concurso, em ordem de concurso
+	Result *RecurringResult `json:"result,omitempty"` // Resultado consolidado (não armazenado no DB)
+}
+
+// RecurringResult consolida a conferência de todos os concursos de uma Teimosinha
+type RecurringResult struct {
+	CheckedContests int     `json:"checked_contests"` // Concursos já conferidos
+	PendingContests int     `json:"pending_contests"` // Concursos ainda não sorteados ou com erro
+	WinningContests int     `json:"winning_contests"` // Concursos em que a aposta foi premiada
+	BestHitCount    int     `json:"best_hit_count"`   // Maior número de acertos entre os concursos
+	TotalCost       float64 `json:"total_cost"`
+	TotalPrize      float64 `json:"total_prize"`
+	NetResult       float64 `json:"net_result"` // TotalPrize - TotalCost
+	Completed       bool    `json:"completed"`  // Se todos os concursos já foram conferidos
+}
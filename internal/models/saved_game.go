@@ -9,16 +9,22 @@ import (
 // SavedGame representa um jogo salvo pelo usuário para verificação posterior
 type SavedGame struct {
 	ID            string      `json:"id" db:"id"`
-	LotteryType   string      `json:"lottery_type" db:"lottery_type"`     // "mega-sena", "lotofacil", etc.
-	Numbers       IntSlice    `json:"numbers" db:"numbers"`               // Números apostados
-	ExpectedDraw  string      `json:"expected_draw" db:"expected_draw"`   // Data esperada do sorteio (YYYY-MM-DD)
-	ContestNumber int         `json:"contest_number" db:"contest_number"` // Número do concurso esperado
-	Status        string      `json:"status" db:"status"`                 // "pending", "checked", "error"
-	Cost          float64     `json:"cost" db:"cost"`                     // Custo do jogo
-	Prize         float64     `json:"prize" db:"prize"`                   // Valor do prêmio (se ganhou)
+	LotteryType   string      `json:"lottery_type" db:"lottery_type"`       // Identificador canônico: "megasena", "lotofacil", etc.
+	Numbers       IntSlice    `json:"numbers" db:"numbers"`                 // Números apostados
+	Secondary     IntSlice    `json:"secondary,omitempty" db:"secondary"`   // Seleção secundária (ex: trevos da +Milionária)
+	ExtraPick     *ExtraPick  `json:"extra_pick,omitempty" db:"extra_pick"` // Palpite extra (Time do Coração, Mês da Sorte)
+	Columns       ColumnSlice `json:"columns,omitempty" db:"columns"`       // Aposta posicional: dígitos por coluna (ex: Super Sete)
+	Picks         StringSlice `json:"picks,omitempty" db:"picks"`           // Loteca: palpite 1/X/2 por partida
+	ExpectedDraw  string      `json:"expected_draw" db:"expected_draw"`     // Data esperada do sorteio (YYYY-MM-DD)
+	ContestNumber int         `json:"contest_number" db:"contest_number"`   // Número do concurso esperado
+	Status        string      `json:"status" db:"status"`                   // "pending", "checked", "error"
+	Cost          float64     `json:"cost" db:"cost"`                       // Custo do jogo
+	Prize         float64     `json:"prize" db:"prize"`                     // Valor do prêmio (se ganhou)
 	CreatedAt     time.Time   `json:"created_at" db:"created_at"`
 	CheckedAt     *time.Time  `json:"checked_at,omitempty" db:"checked_at"`
-	Result        *GameResult `json:"result,omitempty"` // Resultado da verificação (não armazenado no DB)
+	RecurringID   string      `json:"recurring_id,omitempty" db:"recurring_id"` // Teimosinha a que o jogo pertence, se houver
+	PoolID        string      `json:"pool_id,omitempty" db:"pool_id"`           // Bolão a que o jogo pertence, se houver
+	Result        *GameResult `json:"result,omitempty"`                         // Resultado da verificação (não armazenado no DB)
 }
 
 // GameResult representa o resultado da verificação de um jogo salvo
@@ -31,6 +37,53 @@ type GameResult struct {
 	Prize         string  `json:"prize"`          // Faixa de premiação ("quadra", "quina", "sena", etc.)
 	PrizeAmount   float64 `json:"prize_amount"`   // Valor do prêmio (se ganhou)
 	IsWinner      bool    `json:"is_winner"`      // Se ganhou algum prêmio
+
+	// Seleção secundária (ex: trevos da +Milionária)
+	DrawnSecondary    []int `json:"drawn_secondary,omitempty"`     // Trevos sorteados
+	SecondaryMatches  []int `json:"secondary_matches,omitempty"`   // Trevos que o usuário acertou
+	SecondaryHitCount int   `json:"secondary_hit_count,omitempty"` // Quantos trevos acertou
+
+	// Palpite extra (Time do Coração, Mês da Sorte)
+	DrawnExtraPick string `json:"drawn_extra_pick,omitempty"` // Time ou mês sorteado
+	ExtraPickHit   bool   `json:"extra_pick_hit,omitempty"`   // Se acertou o palpite extra
+
+	// Aposta posicional (ex: Super Sete): colunas acertadas, numeradas a partir de 1
+	// Nesse caso HitCount é o número de colunas acertadas e DrawnNumbers segue a ordem das colunas
+	ColumnMatches []int `json:"column_matches,omitempty"`
+
+	// Loteca: resultado de cada partida e partidas acertadas, numeradas a partir de 1
+	// Nesse caso HitCount é o número de partidas acertadas
+	DrawnOutcomes []string `json:"drawn_outcomes,omitempty"`
+	MatchHits     []int    `json:"match_hits,omitempty"`
+
+	// Conferência por sorteio (a Dupla Sena tem dois sorteios por concurso)
+	// Quando preenchido, Prize e PrizeAmount representam a soma de todos os sorteios
+	DrawResults []DrawResult `json:"draw_results,omitempty"`
+
+	// Detalhamento do prêmio por faixa: uma aposta múltipla (desdobramento) pode ganhar várias faixas
+	// Prize resume as faixas e PrizeAmount é a soma dos totais
+	PrizeBreakdown []PrizeTier `json:"prize_breakdown,omitempty"`
+}
+
+// PrizeTier representa uma faixa premiada no detalhamento do prêmio de um jogo
+type PrizeTier struct {
+	Description string  `json:"description"` // Faixa de premiação ("5 acertos", "Quina", ...)
+	Count       int64   `json:"count"`       // Apostas simples premiadas nessa faixa
+	UnitPrize   float64 `json:"unit_prize"`  // Prêmio de cada aposta simples
+	Total       float64 `json:"total"`       // Count x UnitPrize
+}
+
+// DrawResult representa a conferência de um jogo contra um único sorteio do concurso
+type DrawResult struct {
+	DrawIndex    int     `json:"draw_index"`    // 1 = primeiro sorteio, 2 = segundo sorteio
+	DrawnNumbers []int   `json:"drawn_numbers"` // Números sorteados neste sorteio
+	Matches      []int   `json:"matches"`       // Números que o usuário acertou neste sorteio
+	HitCount     int     `json:"hit_count"`     // Quantos números acertou neste sorteio
+	Prize        string  `json:"prize"`         // Faixa de premiação neste sorteio
+	PrizeAmount  float64 `json:"prize_amount"`  // Valor do prêmio neste sorteio
+	IsWinner     bool    `json:"is_winner"`     // Se ganhou neste sorteio
+
+	PrizeBreakdown []PrizeTier `json:"prize_breakdown,omitempty"` // Faixas premiadas neste sorteio
 }
 
 // IntSlice é um helper para serializar []int no SQLite
@@ -58,12 +111,110 @@ func (is *IntSlice) Scan(value interface{}) error {
 	return nil
 }
 
+// ColumnSlice é um helper para serializar [][]int (apostas por colunas) no SQLite
+type ColumnSlice [][]int
+
+// Value implementa driver.Valuer para SQLite
+func (cs ColumnSlice) Value() (driver.Value, error) {
+	if len(cs) == 0 {
+		return nil, nil
+	}
+	data, err := json.Marshal(cs)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan implementa sql.Scanner para SQLite
+func (cs *ColumnSlice) Scan(value interface{}) error {
+	if value == nil {
+		*cs = nil
+		return nil
+	}
+
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, cs)
+	case string:
+		return json.Unmarshal([]byte(v), cs)
+	}
+
+	return nil
+}
+
+// StringSlice é um helper para serializar []string no SQLite
+type StringSlice []string
+
+// Value implementa driver.Valuer para SQLite
+func (ss StringSlice) Value() (driver.Value, error) {
+	if len(ss) == 0 {
+		return nil, nil
+	}
+	data, err := json.Marshal(ss)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan implementa sql.Scanner para SQLite
+func (ss *StringSlice) Scan(value interface{}) error {
+	if value == nil {
+		*ss = nil
+		return nil
+	}
+
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, ss)
+	case string:
+		return json.Unmarshal([]byte(v), ss)
+	}
+
+	return nil
+}
+
+// ExtraPick representa o palpite extra não numérico de um jogo
+type ExtraPick struct {
+	Kind   string `json:"kind"`  // "team" (Time do Coração) ou "month" (Mês da Sorte)
+	Choice string `json:"value"` // Nome do time ou do mês
+}
+
+// Value implementa driver.Valuer para SQLite
+func (ep ExtraPick) Value() (driver.Value, error) {
+	data, err := json.Marshal(ep)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan implementa sql.Scanner para SQLite
+func (ep *ExtraPick) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, ep)
+	case string:
+		return json.Unmarshal([]byte(v), ep)
+	}
+
+	return nil
+}
+
 // SaveGameRequest representa a requisição para salvar um jogo
 type SaveGameRequest struct {
-	LotteryType   string `json:"lottery_type"`
-	Numbers       []int  `json:"numbers"`
-	ExpectedDraw  string `json:"expected_draw"`
-	ContestNumber int    `json:"contest_number"`
+	LotteryType   string     `json:"lottery_type"`
+	Numbers       []int      `json:"numbers"`
+	Secondary     []int      `json:"secondary,omitempty"`  // Seleção secundária (ex: trevos da +Milionária)
+	ExtraPick     *ExtraPick `json:"extra_pick,omitempty"` // Palpite extra (Time do Coração, Mês da Sorte)
+	Columns       [][]int    `json:"columns,omitempty"`    // Aposta posicional: dígitos por coluna (ex: Super Sete)
+	Picks         []string   `json:"picks,omitempty"`      // Loteca: palpite 1/X/2 por partida
+	ExpectedDraw  string     `json:"expected_draw"`
+	ContestNumber int        `json:"contest_number"`
+	Mirror        bool       `json:"mirror,omitempty"`   // Lotomania: salva também a aposta espelho
+	Contests      int        `json:"contests,omitempty"` // Teimosinha: repete a aposta em N concursos consecutivos (2 a 24)
+	PoolID        string     `json:"pool_id,omitempty"`  // Bolão em que o jogo entra
 }
 
 // SavedGamesFilter representa filtros para buscar jogos salvos
@@ -72,4 +223,6 @@ type SavedGamesFilter struct {
 	Status      string `json:"status,omitempty"`
 	FromDate    string `json:"from_date,omitempty"`
 	ToDate      string `json:"to_date,omitempty"`
+	RecurringID string `json:"recurring_id,omitempty"`
+	PoolID      string `json:"pool_id,omitempty"`
 }
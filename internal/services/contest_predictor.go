@@ -33,40 +33,33 @@ func (cp *ContestPredictor) GetTemperatureAnalysis() (*models.PredictorSummary,
 	var hottestLottery, coldestLottery string
 	var coldestScore int = 100
 
-	// Analisar Mega-Sena
-	megaAnalysis, err := cp.analyzeLottery("megasena")
-	if err != nil {
-		logs.LogMain("⚠️ Erro ao analisar Mega-Sena: %v", err)
-	} else {
-		analyses = append(analyses, *megaAnalysis)
-		if megaAnalysis.TemperatureScore > hottestScore {
-			hottestScore = megaAnalysis.TemperatureScore
-			hottestLottery = megaAnalysis.LotteryName
-		}
-		if megaAnalysis.TemperatureScore < coldestScore {
-			coldestScore = megaAnalysis.TemperatureScore
-			coldestLottery = megaAnalysis.LotteryName
+	// Analisar cada loteria registrada com amostra definida (a Loteca fica de fora: não há dezenas para medir temperatura)
+	var lotteryTypes []string
+	for _, def := range lottery.All() {
+		if def.SampleSize > 0 {
+			lotteryTypes = append(lotteryTypes, string(def.Type))
 		}
 	}
+	for _, lotteryType := range lotteryTypes {
+		analysis, err := cp.analyzeLottery(lotteryType)
+		if err != nil {
+			logs.LogMain("⚠️ Erro ao analisar %s: %v", cp.getLotteryDisplayName(lotteryType), err)
+			continue
+		}
 
-	// Analisar Lotofácil
-	lotofacilAnalysis, err := cp.analyzeLottery("lotofacil")
-	if err != nil {
-		logs.LogMain("⚠️ Erro ao analisar Lotofácil: %v", err)
-	} else {
-		analyses = append(analyses, *lotofacilAnalysis)
-		if lotofacilAnalysis.TemperatureScore > hottestScore {
-			hottestScore = lotofacilAnalysis.TemperatureScore
-			hottestLottery = lotofacilAnalysis.LotteryName
+		analyses = append(analyses, *analysis)
+		if analysis.TemperatureScore > hottestScore {
+			hottestScore = analysis.TemperatureScore
+			hottestLottery = analysis.LotteryName
 		}
-		if lotofacilAnalysis.TemperatureScore < coldestScore {
-			coldestScore = lotofacilAnalysis.TemperatureScore
-			coldestLottery = lotofacilAnalysis.LotteryName
+		if analysis.TemperatureScore < coldestScore {
+			coldestScore = analysis.TemperatureScore
+			coldestLottery = analysis.LotteryName
 		}
 	}
 
 	// Calcular confiança geral baseada no número de análises
-	confidence := float64(len(analyses)) / 2.0 * 100.0
+	confidence := float64(len(analyses)) / float64(len(lotteryTypes)) * 100.0
 	if confidence > 100 {
 		confidence = 100
 	}
@@ -144,20 +137,13 @@ func (cp *ContestPredictor) analyzeLottery(lotteryType string) (*models.Temperat
 func (cp *ContestPredictor) getHistoricalData(lotteryType string) ([]models.ConcursoData, error) {
 	logs.LogMain("📥 Obtendo dados históricos para %s...", lotteryType)
 
-	// Converter string para lottery.LotteryType
-	var ltype lottery.LotteryType
-	var optimalSampleSize int
-
-	switch lotteryType {
-	case "megasena":
-		ltype = lottery.MegaSena
-		optimalSampleSize = 200 // ~2 anos de dados (2x por semana)
-	case "lotofacil":
-		ltype = lottery.Lotofacil
-		optimalSampleSize = 300 // ~10 meses de dados (diário)
-	default:
+	// Tipo e tamanho da amostra vêm do registro de loterias
+	def, ok := lottery.Lookup(lotteryType)
+	if !ok || def.SampleSize == 0 {
 		return nil, fmt.Errorf("tipo de loteria não suportado: %s", lotteryType)
 	}
+	ltype := def.Type
+	optimalSampleSize := def.SampleSize
 
 	logs.LogMain("🎯 Tamanho otimizado da amostra para %s: %d sorteios", lotteryType, optimalSampleSize)
 
@@ -186,6 +172,7 @@ func (cp *ContestPredictor) getHistoricalData(lotteryType string) ([]models.Conc
 		var premios []models.PremioFaixa
 		for _, winner := range draw.Winners {
 			premio := models.PremioFaixa{
+				Indice:     winner.Tier,
 				Faixa:      winner.Description,
 				Ganhadores: winner.Winners,
 				Valor:      winner.Prize,
@@ -198,7 +185,8 @@ func (cp *ContestPredictor) getHistoricalData(lotteryType string) ([]models.Conc
 			Data:           draw.Date.Time(),
 			Premios:        premios,
 			Acumulado:      draw.Accumulated,
-			ValorAcumulado: 0, // TODO: Extrair do campo correto quando disponível
+			ValorAcumulado: draw.AccumulatedValue,
+			PremioEstimado: draw.EstimatedNextPrize,
 			IntervaloDias:  intervaloDias,
 			LotteryType:    lotteryType,
 			TemporalWeight: temporalWeight, // Novo campo para ponderação temporal
@@ -228,7 +216,7 @@ func (cp *ContestPredictor) analyzeCycles(historical []models.ConcursoData) mode
 		// Verificar se houve grande prêmio (primeira faixa com ganhadores)
 		hasBigPrize := false
 		for _, premio := range concurso.Premios {
-			if premio.Ganhadores > 0 && premio.Faixa == "1ª faixa" {
+			if premio.Ganhadores > 0 && premio.Indice == 1 {
 				hasBigPrize = true
 				break
 			}
@@ -331,15 +319,11 @@ func (cp *ContestPredictor) analyzeAccumulation(historical []models.ConcursoData
 		}
 	}
 
-	// Valor acumulado atual (simplificado)
-	currentAccumulatedValue := 0.0
-	if len(historical) > 0 {
-		currentAccumulatedValue = historical[0].ValorAcumulado
-	}
-
+	// Valor acumulado e prêmio estimado informados pela CAIXA no último concurso
 	info := models.AccumInfo{
 		ConsecutiveAccumulations: consecutiveAccumulations,
-		CurrentAccumulatedValue:  currentAccumulatedValue,
+		CurrentAccumulatedValue:  historical[0].ValorAcumulado,
+		EstimatedNextPrize:       historical[0].PremioEstimado,
 		AverageBeforeExplosion:   averageBeforeExplosion,
 		ExplosionProbability:     explosionProbability,
 	}
@@ -496,14 +480,10 @@ func (cp *ContestPredictor) predictNextDraw(cycle models.CycleInfo, accum models
 
 // getLotteryDisplayName retorna nome amigável da loteria
 func (cp *ContestPredictor) getLotteryDisplayName(lotteryType string) string {
-	switch lotteryType {
-	case "megasena":
-		return "Mega-Sena"
-	case "lotofacil":
-		return "Lotofácil"
-	default:
-		return lotteryType
+	if def, ok := lottery.Lookup(lotteryType); ok {
+		return def.Name()
 	}
+	return lotteryType
 }
 
 // generateGeneralAdvice gera conselho geral baseado nas análises
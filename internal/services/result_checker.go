@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"sort"
+	"strings"
 	"time"
 
 	"lottery-optimizer-gui/internal/data"
@@ -27,6 +28,8 @@ func NewResultChecker(dataClient *data.Client, db *database.SavedGamesDB) *Resul
 
 // CheckPendingResults verifica todos os jogos pendentes automaticamente
 func (rc *ResultChecker) CheckPendingResults() error {
+	rc.ApplyDrawCorrections()
+
 	pendingGames, err := rc.db.GetPendingGames()
 	if err != nil {
 		return fmt.Errorf("erro ao buscar jogos pendentes: %w", err)
@@ -44,8 +47,11 @@ func (rc *ResultChecker) CheckPendingResults() error {
 		}
 
 		if result != nil {
-			// Resultado encontrado, marcar como verificado
-			rc.db.UpdateGameStatus(game.ID, "checked")
+			// Resultado encontrado: grava acertos, prêmio e detalhamento por faixa (e marca como verificado)
+			if err := rc.db.UpdateGameResult(game.ID, result); err != nil {
+				log.Printf("Erro ao salvar resultado do jogo %s: %v", game.ID, err)
+				continue
+			}
 			log.Printf("Jogo %s verificado: %d acertos", game.ID, result.HitCount)
 		}
 		// Se result for nil, significa que o sorteio ainda não aconteceu
@@ -54,18 +60,43 @@ func (rc *ResultChecker) CheckPendingResults() error {
 	return nil
 }
 
+// ApplyDrawCorrections reabre os jogos conferidos com um resultado manual que o oficial corrigiu
+// Correções sem divergência só são marcadas como aplicadas; retorna quantos jogos voltaram para conferência
+func (rc *ResultChecker) ApplyDrawCorrections() int {
+	corrections, err := rc.dataClient.DrawCorrections("", true)
+	if err != nil {
+		log.Printf("Erro ao buscar correções de resultados manuais: %v", err)
+		return 0
+	}
+
+	reopened := 0
+	for _, correction := range corrections {
+		if len(correction.Discrepancies) > 0 {
+			count, err := rc.db.ReopenCheckedGames(lottery.LotteryType(correction.LotteryType), correction.Number)
+			if err != nil {
+				log.Printf("Erro ao reabrir jogos de %s concurso %d: %v", correction.LotteryType, correction.Number, err)
+				continue
+			}
+			reopened += count
+		}
+
+		if err := rc.dataClient.MarkCorrectionApplied(correction.ID); err != nil {
+			log.Printf("Erro ao marcar correção %d como aplicada: %v", correction.ID, err)
+		}
+	}
+
+	return reopened
+}
+
 // CheckGameResult verifica o resultado de um jogo específico
+// Não grava nada: UpdateGameResult salva o resultado e, em jogos de bolão, divide o prêmio pelas cotas
 func (rc *ResultChecker) CheckGameResult(game models.SavedGame) (*models.GameResult, error) {
-	// Converter tipo de loteria para o formato interno
-	var lotteryType lottery.LotteryType
-	switch game.LotteryType {
-	case "mega-sena":
-		lotteryType = lottery.MegaSena
-	case "lotofacil":
-		lotteryType = lottery.Lotofacil
-	default:
-		return nil, fmt.Errorf("tipo de loteria não suportado: %s", game.LotteryType)
+	// Converter tipo de loteria para o formato interno (aceita grafias legadas como "mega-sena")
+	lotteryType, err := lottery.ParseLotteryType(game.LotteryType)
+	if err != nil {
+		return nil, err
 	}
+	def, _ := lottery.Get(lotteryType)
 
 	// Buscar resultado do concurso específico
 	draw, err := rc.dataClient.GetDrawByNumber(lotteryType, game.ContestNumber)
@@ -79,6 +110,21 @@ func (rc *ResultChecker) CheckGameResult(game models.SavedGame) (*models.GameRes
 		return nil, nil // Sorteio ainda não aconteceu
 	}
 
+	// Loterias com mais de um sorteio por concurso (ex: Dupla Sena) conferem cada sorteio e somam os prêmios
+	if def.Rules.HasMultipleDraws() {
+		return rc.checkMultiDrawResult(def, game, draw), nil
+	}
+
+	// Apostas posicionais (ex: Super Sete) conferem coluna a coluna, não por conjunto
+	if def.Rules.IsPositional() {
+		return rc.checkPositionalResult(def, game, draw), nil
+	}
+
+	// Loteca confere o palpite de cada partida com o placar apurado
+	if def.Rules.IsMatchPool() {
+		return rc.checkLotecaResult(def, game, draw), nil
+	}
+
 	// Calcular acertos
 	userNumbers := []int(game.Numbers)
 	drawnNumbers := draw.Numbers.ToIntSlice()
@@ -92,9 +138,29 @@ func (rc *ResultChecker) CheckGameResult(game models.SavedGame) (*models.GameRes
 		HitCount:      len(matches),
 		IsWinner:      false,
 	}
+	hits := lottery.Hits{Numbers: result.HitCount}
+
+	// Loterias com seleção secundária (ex: trevos) conferem as duas matrizes
+	if def.Rules.HasSecondary() {
+		result.DrawnSecondary = draw.Secondary.ToIntSlice()
+		result.SecondaryMatches = findMatches([]int(game.Secondary), result.DrawnSecondary)
+		result.SecondaryHitCount = len(result.SecondaryMatches)
+		hits.Secondary = result.SecondaryHitCount
+	}
+
+	// Loterias com palpite extra (Time do Coração, Mês da Sorte) somam o prêmio do palpite
+	if def.Rules.HasExtraPick() {
+		result.DrawnExtraPick = draw.ExtraPick
+		if game.ExtraPick != nil {
+			result.ExtraPickHit = lottery.MatchExtraPick(game.ExtraPick.Choice, draw.ExtraPick)
+		}
+		hits.ExtraPick = result.ExtraPickHit
+	}
 
-	// Determinar premiação baseada no tipo de loteria e número de acertos
-	result.Prize, result.PrizeAmount, result.IsWinner = rc.calculatePrize(game.LotteryType, result.HitCount, draw)
+	// Determinar premiação pelas faixas registradas da loteria
+	// Apostas múltiplas são desdobradas nas apostas simples equivalentes e podem ganhar várias faixas
+	expanded := lottery.ExpandHits(def.Rules, len(game.Numbers), hits, len(game.Secondary))
+	result.Prize, result.PrizeAmount, result.IsWinner, result.PrizeBreakdown = rc.resolvePrize(def, expanded, hits, draw)
 
 	return result, nil
 }
@@ -114,13 +180,227 @@ func (rc *ResultChecker) CheckSingleGame(gameID string) (*models.GameResult, err
 	}
 
 	if result != nil {
-		// Marcar como verificado
-		rc.db.UpdateGameStatus(gameID, "checked")
+		// Gravar o resultado completo, que também marca o jogo como verificado
+		if err := rc.db.UpdateGameResult(gameID, result); err != nil {
+			return nil, fmt.Errorf("erro ao salvar resultado: %w", err)
+		}
 	}
 
 	return result, nil
 }
 
+// CheckRecurringBet confere os concursos pendentes de uma Teimosinha e consolida o resultado da sequência
+func (rc *ResultChecker) CheckRecurringBet(recurringID string) (*models.RecurringBet, error) {
+	bet, err := rc.db.GetRecurringBet(recurringID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, game := range bet.Games {
+		if game.Status == "checked" {
+			continue
+		}
+
+		result, err := rc.CheckGameResult(game)
+		if err != nil {
+			log.Printf("Erro ao verificar concurso %d da teimosinha %s: %v", game.ContestNumber, bet.ID, err)
+			rc.db.UpdateGameStatus(game.ID, "error")
+			bet.Games[i].Status = "error"
+			continue
+		}
+		if result == nil {
+			continue // Sorteio ainda não aconteceu
+		}
+
+		if err := rc.db.UpdateGameResult(game.ID, result); err != nil {
+			log.Printf("Erro ao salvar concurso %d da teimosinha %s: %v", game.ContestNumber, bet.ID, err)
+			continue
+		}
+		bet.Games[i].Status = "checked"
+		bet.Games[i].Prize = result.PrizeAmount
+		bet.Games[i].Result = result
+	}
+
+	bet.Result = RollUpRecurringBet(bet)
+	return bet, nil
+}
+
+// RollUpRecurringBet soma custo e prêmios de todos os concursos de uma Teimosinha
+func RollUpRecurringBet(bet *models.RecurringBet) *models.RecurringResult {
+	rollUp := &models.RecurringResult{TotalCost: bet.TotalCost}
+
+	for _, game := range bet.Games {
+		if game.Status != "checked" {
+			rollUp.PendingContests++
+			continue
+		}
+
+		rollUp.CheckedContests++
+		rollUp.TotalPrize += game.Prize
+		if game.Prize > 0 || (game.Result != nil && game.Result.IsWinner) {
+			rollUp.WinningContests++
+		}
+		if game.Result != nil && game.Result.HitCount > rollUp.BestHitCount {
+			rollUp.BestHitCount = game.Result.HitCount
+		}
+	}
+
+	// Sequências gravadas sem custo total somam o custo de cada concurso
+	if rollUp.TotalCost == 0 {
+		for _, game := range bet.Games {
+			rollUp.TotalCost += game.Cost
+		}
+	}
+
+	rollUp.NetResult = rollUp.TotalPrize - rollUp.TotalCost
+	rollUp.Completed = rollUp.PendingContests == 0 && rollUp.CheckedContests == bet.Contests
+	return rollUp
+}
+
+// checkMultiDrawResult confere um jogo contra cada sorteio do concurso (ex: os dois da Dupla Sena)
+func (rc *ResultChecker) checkMultiDrawResult(def lottery.Definition, game models.SavedGame, draw *lottery.Draw) *models.GameResult {
+	userNumbers := []int(game.Numbers)
+
+	result := &models.GameResult{
+		ContestNumber: draw.Number,
+		DrawDate:      draw.Date.String(),
+		IsWinner:      false,
+	}
+
+	var prizes []string
+	for i, drawnNumbers := range draw.DrawnSets() {
+		matches := findMatches(userNumbers, drawnNumbers)
+
+		drawResult := models.DrawResult{
+			DrawIndex:    i + 1,
+			DrawnNumbers: drawnNumbers,
+			Matches:      matches,
+			HitCount:     len(matches),
+		}
+		hits := lottery.Hits{Numbers: drawResult.HitCount, DrawIndex: drawResult.DrawIndex}
+		expanded := lottery.ExpandHits(def.Rules, len(userNumbers), hits, 0)
+		drawResult.Prize, drawResult.PrizeAmount, drawResult.IsWinner, drawResult.PrizeBreakdown = rc.resolvePrize(def, expanded, hits, draw)
+
+		result.DrawResults = append(result.DrawResults, drawResult)
+
+		// O resumo usa o primeiro sorteio e o melhor número de acertos entre os dois
+		if i == 0 {
+			result.DrawnNumbers = drawnNumbers
+			result.Matches = matches
+		}
+		if drawResult.HitCount > result.HitCount {
+			result.HitCount = drawResult.HitCount
+		}
+
+		if drawResult.IsWinner {
+			result.IsWinner = true
+			result.PrizeAmount += drawResult.PrizeAmount
+			prizes = append(prizes, fmt.Sprintf("%s no %dº sorteio", drawResult.Prize, drawResult.DrawIndex))
+			for _, tier := range drawResult.PrizeBreakdown {
+				tier.Description = fmt.Sprintf("%s no %dº sorteio", tier.Description, drawResult.DrawIndex)
+				result.PrizeBreakdown = append(result.PrizeBreakdown, tier)
+			}
+		}
+	}
+
+	if len(prizes) > 0 {
+		result.Prize = strings.Join(prizes, " + ")
+	} else {
+		result.Prize = fmt.Sprintf("%d acertos", result.HitCount)
+	}
+
+	return result
+}
+
+// checkPositionalResult confere uma aposta por colunas: cada coluna acerta se contém o dígito sorteado nela
+// A CAIXA publica os dígitos na ordem das colunas, por isso eles não são ordenados
+func (rc *ResultChecker) checkPositionalResult(def lottery.Definition, game models.SavedGame, draw *lottery.Draw) *models.GameResult {
+	drawnDigits := draw.Numbers.ToIntSlice()
+	columnMatches := findColumnMatches([][]int(game.Columns), drawnDigits)
+
+	result := &models.GameResult{
+		ContestNumber: draw.Number,
+		DrawDate:      draw.Date.String(),
+		DrawnNumbers:  drawnDigits,
+		ColumnMatches: columnMatches,
+		HitCount:      len(columnMatches),
+		IsWinner:      false,
+	}
+
+	// Matches guarda o dígito acertado em cada coluna premiada
+	marked := make([]int, len(game.Columns))
+	hit := make([]bool, len(game.Columns))
+	for i, column := range game.Columns {
+		marked[i] = len(column)
+	}
+	for _, column := range columnMatches {
+		result.Matches = append(result.Matches, drawnDigits[column-1])
+		hit[column-1] = true
+	}
+
+	// Colunas com mais de um dígito desdobram o jogo em várias apostas simples
+	hits := lottery.Hits{Numbers: result.HitCount}
+	expanded := lottery.ExpandPositionHits(marked, hit)
+	result.Prize, result.PrizeAmount, result.IsWinner, result.PrizeBreakdown = rc.resolvePrize(def, expanded, hits, draw)
+	return result
+}
+
+// checkLotecaResult confere os palpites da Loteca: a partida é acertada se o resultado está entre os marcados
+// Um concurso sem todos os placares apurados ainda não pode ser conferido
+func (rc *ResultChecker) checkLotecaResult(def lottery.Definition, game models.SavedGame, draw *lottery.Draw) *models.GameResult {
+	outcomes := draw.MatchOutcomes()
+	for _, outcome := range outcomes {
+		if outcome == "" {
+			return nil
+		}
+	}
+	if len(outcomes) == 0 {
+		return nil
+	}
+
+	result := &models.GameResult{
+		ContestNumber: draw.Number,
+		DrawDate:      draw.Date.String(),
+		DrawnOutcomes: outcomes,
+		MatchHits:     []int{},
+		IsWinner:      false,
+	}
+
+	marked := make([]int, len(game.Picks))
+	hit := make([]bool, len(game.Picks))
+	for i, pick := range game.Picks {
+		marked[i] = len(pick)
+		if i < len(outcomes) && strings.Contains(pick, outcomes[i]) {
+			result.MatchHits = append(result.MatchHits, i+1)
+			hit[i] = true
+		}
+	}
+	result.HitCount = len(result.MatchHits)
+
+	// Duplos e triplos desdobram o volante em um prognóstico simples por combinação de palpites
+	hits := lottery.Hits{Numbers: result.HitCount}
+	expanded := lottery.ExpandPositionHits(marked, hit)
+	result.Prize, result.PrizeAmount, result.IsWinner, result.PrizeBreakdown = rc.resolvePrize(def, expanded, hits, draw)
+	return result
+}
+
+// findColumnMatches retorna as colunas (a partir de 1) em que o dígito sorteado foi marcado
+func findColumnMatches(columns [][]int, drawnDigits []int) []int {
+	matches := []int{}
+	for i, column := range columns {
+		if i >= len(drawnDigits) {
+			break
+		}
+		for _, digit := range column {
+			if digit == drawnDigits[i] {
+				matches = append(matches, i+1)
+				break
+			}
+		}
+	}
+	return matches
+}
+
 // findMatches encontra números que coincidem entre duas listas
 func findMatches(userNumbers, drawnNumbers []int) []int {
 	drawnSet := make(map[int]bool)
@@ -139,104 +419,64 @@ func findMatches(userNumbers, drawnNumbers []int) []int {
 	return matches
 }
 
-// calculatePrize calcula a premiação baseada no tipo de loteria e acertos
-func (rc *ResultChecker) calculatePrize(lotteryType string, hitCount int, draw *lottery.Draw) (string, float64, bool) {
-	switch lotteryType {
-	case "mega-sena":
-		return rc.calculateMegaSenaPrize(hitCount, draw)
-	case "lotofacil":
-		return rc.calculateLotofacilPrize(hitCount, draw)
-	default:
+// calculatePrize calcula a premiação pelas faixas que a loteria registrou
+func (rc *ResultChecker) calculatePrize(def lottery.Definition, hits lottery.Hits, draw *lottery.Draw) (string, float64, bool) {
+	if def.Prize == nil {
 		return "Tipo não suportado", 0, false
 	}
+	return def.Prize(hits, draw)
+}
+
+// resolvePrize resolve o prêmio de cada grupo de apostas simples de um desdobramento e soma as faixas
+// Retorna a descrição resumida, o valor total, se houve prêmio e o detalhamento por faixa
+// Sem nenhuma faixa premiada a descrição é a da conferência do jogo inteiro (ex: "3 acertos")
+func (rc *ResultChecker) resolvePrize(def lottery.Definition, expanded []lottery.SimpleBetHits, hits lottery.Hits, draw *lottery.Draw) (string, float64, bool, []models.PrizeTier) {
+	var breakdown []models.PrizeTier
+	tierIndex := make(map[string]int)
+	var total float64
+
+	for _, group := range expanded {
+		description, amount, isWinner := rc.calculatePrize(def, group.Hits, draw)
+		if !isWinner {
+			continue
+		}
+
+		groupTotal := amount * float64(group.Count)
+		total += groupTotal
+
+		if i, exists := tierIndex[description]; exists {
+			breakdown[i].Count += group.Count
+			breakdown[i].Total += groupTotal
+			continue
+		}
+		tierIndex[description] = len(breakdown)
+		breakdown = append(breakdown, models.PrizeTier{
+			Description: description,
+			Count:       group.Count,
+			UnitPrize:   amount,
+			Total:       groupTotal,
+		})
+	}
+
+	if len(breakdown) == 0 {
+		description, _, _ := rc.calculatePrize(def, hits, draw)
+		return description, 0, false, nil
+	}
+
+	return summarizePrizeBreakdown(breakdown), total, true, breakdown
 }
 
-// calculateMegaSenaPrize calcula premiação da Mega-Sena
-func (rc *ResultChecker) calculateMegaSenaPrize(hitCount int, draw *lottery.Draw) (string, float64, bool) {
-	// Buscar prêmios nos ganhadores
-	var senaValue, quinaValue, quadraValue float64
-
-	for _, winner := range draw.Winners {
-		switch winner.Description {
-		case "Sena":
-			senaValue = winner.Prize
-		case "Quina":
-			quinaValue = winner.Prize
-		case "Quadra":
-			quadraValue = winner.Prize
-		}
-	}
-
-	switch hitCount {
-	case 6:
-		return "Sena (6 acertos)", senaValue, true
-	case 5:
-		return "Quina (5 acertos)", quinaValue, true
-	case 4:
-		return "Quadra (4 acertos)", quadraValue, true
-	default:
-		return fmt.Sprintf("%d acertos", hitCount), 0, false
-	}
-}
-
-// calculateLotofacilPrize calcula premiação da Lotofácil
-func (rc *ResultChecker) calculateLotofacilPrize(hitCount int, draw *lottery.Draw) (string, float64, bool) {
-	// Buscar prêmios nos ganhadores
-	prizeMap := make(map[int]float64)
-
-	// LOG DEBUG: Mostrar todas as descriptions da API
-	log.Printf("🔍 DEBUG - Descriptions da API para concurso %d:", draw.Number)
-	for _, winner := range draw.Winners {
-		log.Printf("  - Description: '%s', Winners: %d, Prize: R$ %.2f", winner.Description, winner.Winners, winner.Prize)
-	}
-
-	for _, winner := range draw.Winners {
-		switch winner.Description {
-		// Formato "X acertos"
-		case "15 acertos":
-			prizeMap[15] = winner.Prize
-		case "14 acertos":
-			prizeMap[14] = winner.Prize
-		case "13 acertos":
-			prizeMap[13] = winner.Prize
-		case "12 acertos":
-			prizeMap[12] = winner.Prize
-		case "11 acertos":
-			prizeMap[11] = winner.Prize
-		// Formato "X pontos" (usado pela API da CAIXA)
-		case "15 pontos":
-			prizeMap[15] = winner.Prize
-		case "14 pontos":
-			prizeMap[14] = winner.Prize
-		case "13 pontos":
-			prizeMap[13] = winner.Prize
-		case "12 pontos":
-			prizeMap[12] = winner.Prize
-		case "11 pontos":
-			prizeMap[11] = winner.Prize
-		// Formato com faixas
-		case "Faixa 1 (15 pontos)":
-			prizeMap[15] = winner.Prize
-		case "Faixa 2 (14 pontos)":
-			prizeMap[14] = winner.Prize
-		case "Faixa 3 (13 pontos)":
-			prizeMap[13] = winner.Prize
-		case "Faixa 4 (12 pontos)":
-			prizeMap[12] = winner.Prize
-		case "Faixa 5 (11 pontos)":
-			prizeMap[11] = winner.Prize
-		default:
-			log.Printf("⚠️ Description não mapeada: '%s'", winner.Description)
-		}
-	}
-
-	if prize, exists := prizeMap[hitCount]; exists && hitCount >= 11 {
-		log.Printf("✅ Prêmio encontrado para %d acertos: R$ %.2f", hitCount, prize)
-		return fmt.Sprintf("%d acertos", hitCount), prize, true
-	}
-
-	log.Printf("❌ Nenhum prêmio encontrado para %d acertos", hitCount)
-	return fmt.Sprintf("%d acertos", hitCount), 0, false
+// summarizePrizeBreakdown descreve as faixas ganhas (ex: "2x Quina + 5x Quadra")
+func summarizePrizeBreakdown(breakdown []models.PrizeTier) string {
+	parts := make([]string, 0, len(breakdown))
+	for _, tier := range breakdown {
+		if tier.Count == 1 {
+			parts = append(parts, tier.Description)
+		} else {
+			parts = append(parts, fmt.Sprintf("%dx %s", tier.Count, tier.Description))
+		}
+	}
+	return strings.Join(parts, " + ")
 }
 
 // ScheduleAutoCheck agenda verificações automáticas
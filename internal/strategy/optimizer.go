@@ -3,6 +3,7 @@ package strategy
 import (
 	"fmt"
 	"lottery-optimizer-gui/internal/lottery"
+	"math"
 	"math/rand"
 	"sort"
 	"time"
@@ -102,6 +103,16 @@ func ValidateAndAdjustStrategy(strategy *lottery.Strategy, prefs lottery.UserPre
 func fixGame(game lottery.Game, prefs lottery.UserPreferences) *lottery.Game {
 	rules := lottery.GetRules(game.Type)
 
+	// Apostas por colunas são corrigidas coluna a coluna
+	if rules.IsPositional() {
+		return fixPositionalGame(game, prefs)
+	}
+
+	// Palpites da Loteca são corrigidos partida a partida
+	if rules.IsMatchPool() {
+		return fixMatchPoolGame(game, prefs)
+	}
+
 	// Log detalhado do problema
 	fmt.Printf("🔧 Corrigindo jogo inválido: %s com %d números: %v\n",
 		game.Type, len(game.Numbers), game.Numbers)
@@ -158,7 +169,21 @@ func fixGame(game lottery.Game, prefs lottery.UserPreferences) *lottery.Game {
 		fmt.Printf("✂️ Limitado a %d números (máximo permitido)\n", rules.MaxNumbers)
 	}
 
-	cost := lottery.CalculateGameCost(game.Type, len(validNumbers))
+	fixed := lottery.Game{
+		Type:      game.Type,
+		Numbers:   validNumbers,
+		Secondary: fixSecondary(game.Secondary, rules),
+		ExtraPick: fixExtraPick(game.Type, game.ExtraPick, prefs),
+	}
+	if rules.HasExtraPick() && fixed.ExtraPick == "" {
+		fmt.Printf("❌ FALHA TOTAL: %s sem %s válido\n", game.Type, rules.ExtraPickName)
+		return nil
+	}
+	cost, err := lottery.GameCost(fixed)
+	if err != nil {
+		fmt.Printf("❌ FALHA TOTAL: %v\n", err)
+		return nil
+	}
 
 	fmt.Printf("✅ Jogo corrigido: %s com %d números: %v (R$ %.2f)\n",
 		game.Type, len(validNumbers), validNumbers, cost)
@@ -166,12 +191,137 @@ func fixGame(game lottery.Game, prefs lottery.UserPreferences) *lottery.Game {
 	return &lottery.Game{
 		Type:           game.Type,
 		Numbers:        validNumbers,
+		Secondary:      fixed.Secondary,
+		ExtraPick:      fixed.ExtraPick,
 		Cost:           cost,
-		ExpectedReturn: calculateExpectedReturn(game.Type, validNumbers),
+		ExpectedReturn: calculateExpectedReturn(game.Type, validNumbers, prefs),
 		Probability:    calculateProbability(game.Type, len(validNumbers)),
 	}
 }
 
+// fixPositionalGame corrige uma aposta por colunas mantendo os dígitos válidos de cada coluna
+func fixPositionalGame(game lottery.Game, prefs lottery.UserPreferences) *lottery.Game {
+	rules := lottery.GetRules(game.Type)
+
+	fmt.Printf("🔧 Corrigindo aposta por colunas inválida: %s com colunas %v\n", game.Type, game.Columns)
+
+	columns := make([][]int, rules.Columns)
+	for i := range columns {
+		valid := []int{}
+		if i < len(game.Columns) {
+			for _, digit := range removeDuplicates(game.Columns[i]) {
+				if digit >= rules.MinDigit && digit <= rules.MaxDigit {
+					valid = append(valid, digit)
+				}
+			}
+		}
+
+		if len(valid) > rules.MaxMarksPerColumn {
+			valid = valid[:rules.MaxMarksPerColumn]
+		}
+
+		// Toda coluna precisa de ao menos um dígito
+		if len(valid) == 0 {
+			valid = append(valid, rules.MinDigit+rand.Intn(rules.MaxDigit-rules.MinDigit+1))
+			fmt.Printf("➕ Adicionado dígito %d na coluna %d\n", valid[0], i+1)
+		}
+
+		sort.Ints(valid)
+		columns[i] = valid
+	}
+
+	fixed := buildPositionalGame(game.Type, columns, prefs)
+
+	fmt.Printf("✅ Aposta corrigida: %s com colunas %v (R$ %.2f)\n", game.Type, fixed.Columns, fixed.Cost)
+
+	return fixed
+}
+
+// fixMatchPoolGame corrige os palpites da Loteca mantendo os válidos e sorteando os demais
+func fixMatchPoolGame(game lottery.Game, prefs lottery.UserPreferences) *lottery.Game {
+	rules := lottery.GetRules(game.Type)
+
+	fmt.Printf("🔧 Corrigindo palpites inválidos: %s com palpites %v\n", game.Type, game.Picks)
+
+	picks := make([]string, rules.Matches)
+	multiple := false
+	for i := range picks {
+		if i < len(game.Picks) {
+			if normalized, err := lottery.NormalizeMatchPick(game.Picks[i]); err == nil {
+				picks[i] = normalized
+			}
+		}
+		if picks[i] == "" {
+			picks[i] = randomOutcome()
+			fmt.Printf("➕ Palpite %s sorteado para a partida %d\n", picks[i], i+1)
+		}
+		if len(picks[i]) > 1 {
+			multiple = true
+		}
+	}
+
+	// A aposta mínima inclui um duplo: sem ele, duplicar o palpite de uma partida
+	if !multiple {
+		addDouble(picks)
+	}
+
+	fixed := buildMatchPoolGame(game.Type, picks, prefs)
+
+	fmt.Printf("✅ Palpites corrigidos: %s com palpites %v (R$ %.2f)\n", game.Type, fixed.Picks, fixed.Cost)
+
+	return fixed
+}
+
+// fixSecondary corrige a seleção secundária (ex: trevos) mantendo o que for válido
+func fixSecondary(secondary []int, rules lottery.LotteryRules) []int {
+	if !rules.HasSecondary() {
+		return nil
+	}
+
+	valid := []int{}
+	for _, num := range removeDuplicates(secondary) {
+		if num >= 1 && num <= rules.SecondaryRange {
+			valid = append(valid, num)
+		}
+	}
+
+	if len(valid) > rules.SecondaryMax {
+		valid = valid[:rules.SecondaryMax]
+	}
+
+	for len(valid) < rules.SecondaryMin {
+		num := rand.Intn(rules.SecondaryRange) + 1
+		if !contains(valid, num) {
+			valid = append(valid, num)
+		}
+	}
+
+	sort.Ints(valid)
+	return valid
+}
+
+// fixExtraPick corrige o palpite extra (Time do Coração, Mês da Sorte)
+// Usa o palpite do jogo se válido, senão a preferência do usuário, senão uma opção aleatória
+func fixExtraPick(ltype lottery.LotteryType, pick string, prefs lottery.UserPreferences) string {
+	rules := lottery.GetRules(ltype)
+	if !rules.HasExtraPick() {
+		return ""
+	}
+
+	for _, candidate := range []string{pick, prefs.ExtraPicks[ltype]} {
+		if normalized, err := lottery.NormalizeExtraPick(ltype, candidate); err == nil {
+			return normalized
+		}
+	}
+
+	// Clubes não têm lista fechada: sem preferência não há como escolher
+	if len(rules.ExtraPickOptions) == 0 {
+		return ""
+	}
+
+	return rules.ExtraPickOptions[rand.Intn(len(rules.ExtraPickOptions))]
+}
+
 // generateAdditionalGames gera jogos adicionais para completar o orçamento
 func generateAdditionalGames(prefs lottery.UserPreferences, currentCost float64) []lottery.Game {
 	var games []lottery.Game
@@ -200,6 +350,14 @@ func generateRandomGame(ltype lottery.LotteryType, prefs lottery.UserPreferences
 	rules := lottery.GetRules(ltype)
 	rand.Seed(time.Now().UnixNano())
 
+	if rules.IsPositional() {
+		return generatePositionalGame(ltype, prefs)
+	}
+
+	if rules.IsMatchPool() {
+		return generateMatchPoolGame(ltype, prefs)
+	}
+
 	// Determinar quantidade de números baseado na estratégia
 	numCount := rules.MinNumbers
 	switch prefs.Strategy {
@@ -216,6 +374,17 @@ func generateRandomGame(ltype lottery.LotteryType, prefs lottery.UserPreferences
 		}
 	}
 
+	// Loterias de aposta fixa (ex: Lotomania com 50 números) não variam a quantidade
+	if numCount > rules.MaxNumbers {
+		numCount = rules.MaxNumbers
+	}
+
+	// Filtros de padrão só fazem sentido quando o jogo cobre uma parte pequena do volante
+	numberPrefs := prefs
+	if numCount*2 >= rules.NumberRange {
+		numberPrefs.AvoidPatterns = false
+	}
+
 	var numbers []int
 
 	// Incluir números favoritos se especificados
@@ -229,7 +398,7 @@ func generateRandomGame(ltype lottery.LotteryType, prefs lottery.UserPreferences
 
 	// Completar com números aleatórios
 	for len(numbers) < numCount {
-		num := generateRandomNumber(rules.NumberRange, append(numbers, prefs.ExcludeNumbers...), prefs)
+		num := generateRandomNumber(rules.NumberRange, append(numbers, prefs.ExcludeNumbers...), numberPrefs)
 		if num > 0 {
 			numbers = append(numbers, num)
 		}
@@ -242,17 +411,169 @@ func generateRandomGame(ltype lottery.LotteryType, prefs lottery.UserPreferences
 		return nil
 	}
 
-	cost := lottery.CalculateGameCost(ltype, len(numbers))
+	game := lottery.Game{
+		Type:      ltype,
+		Numbers:   numbers,
+		Secondary: fixSecondary(nil, rules),
+		ExtraPick: fixExtraPick(ltype, "", prefs),
+	}
+	if rules.HasExtraPick() && game.ExtraPick == "" {
+		fmt.Printf("⚠️ %s: informe o %s nas preferências para gerar jogos\n", rules.Name, rules.ExtraPickName)
+		return nil
+	}
+	cost, err := lottery.GameCost(game)
+	if err != nil {
+		fmt.Printf("⚠️ %s: %v\n", rules.Name, err)
+		return nil
+	}
 
 	return &lottery.Game{
 		Type:           ltype,
 		Numbers:        numbers,
+		Secondary:      game.Secondary,
+		ExtraPick:      game.ExtraPick,
 		Cost:           cost,
-		ExpectedReturn: calculateExpectedReturn(ltype, numbers),
+		ExpectedReturn: calculateExpectedReturn(ltype, numbers, prefs),
 		Probability:    calculateProbability(ltype, len(numbers)),
 	}
 }
 
+// generatePositionalGame gera uma aposta por colunas com um dígito por coluna
+// Estratégias mais agressivas marcam dígitos extras em colunas aleatórias
+func generatePositionalGame(ltype lottery.LotteryType, prefs lottery.UserPreferences) *lottery.Game {
+	rules := lottery.GetRules(ltype)
+	digitCount := rules.MaxDigit - rules.MinDigit + 1
+
+	extraMarks := 0
+	switch prefs.Strategy {
+	case "aggressive":
+		extraMarks = rand.Intn(3)
+	case "balanced":
+		if rand.Float32() < 0.3 {
+			extraMarks = 1
+		}
+	}
+
+	columns := make([][]int, rules.Columns)
+	for i := range columns {
+		columns[i] = []int{rules.MinDigit + rand.Intn(digitCount)}
+	}
+
+	for extraMarks > 0 {
+		col := rand.Intn(rules.Columns)
+		if len(columns[col]) >= rules.MaxMarksPerColumn {
+			continue
+		}
+
+		digit := rules.MinDigit + rand.Intn(digitCount)
+		if contains(columns[col], digit) {
+			continue
+		}
+
+		columns[col] = append(columns[col], digit)
+		sort.Ints(columns[col])
+		extraMarks--
+	}
+
+	return buildPositionalGame(ltype, columns, prefs)
+}
+
+// buildPositionalGame monta uma aposta por colunas com custo, retorno e probabilidade calculados
+func buildPositionalGame(ltype lottery.LotteryType, columns [][]int, prefs lottery.UserPreferences) *lottery.Game {
+	rules := lottery.GetRules(ltype)
+	game := lottery.Game{Type: ltype, Columns: columns}
+	cost, err := lottery.GameCost(game)
+	if err != nil {
+		fmt.Printf("⚠️ %s: %v\n", game.Type, err)
+		return nil
+	}
+	game.Cost = cost
+
+	// Cada combinação de um dígito por coluna é uma aposta simples
+	simpleBets := game.Cost / rules.BasePrice
+	game.Probability = simpleBets / math.Pow(float64(rules.MaxDigit-rules.MinDigit+1), float64(rules.Columns))
+	game.ExpectedReturn = game.Probability * averagePrize(ltype, prefs)
+
+	return &game
+}
+
+// generateMatchPoolGame gera palpites aleatórios da Loteca com o duplo da aposta mínima
+// Estratégias mais agressivas marcam duplos extras
+func generateMatchPoolGame(ltype lottery.LotteryType, prefs lottery.UserPreferences) *lottery.Game {
+	rules := lottery.GetRules(ltype)
+
+	extraDoubles := 0
+	switch prefs.Strategy {
+	case "aggressive":
+		extraDoubles = rand.Intn(3)
+	case "balanced":
+		if rand.Float32() < 0.3 {
+			extraDoubles = 1
+		}
+	}
+
+	picks := make([]string, rules.Matches)
+	for i := range picks {
+		picks[i] = randomOutcome()
+	}
+
+	for i := 0; i <= extraDoubles; i++ {
+		addDouble(picks)
+	}
+
+	return buildMatchPoolGame(ltype, picks, prefs)
+}
+
+// randomOutcome sorteia um palpite simples ("1", "X" ou "2")
+func randomOutcome() string {
+	outcomes := []string{lottery.OutcomeHome, lottery.OutcomeDraw, lottery.OutcomeAway}
+	return outcomes[rand.Intn(len(outcomes))]
+}
+
+// addDouble transforma o palpite simples de uma partida aleatória em duplo
+func addDouble(picks []string) {
+	var singles []int
+	for i, pick := range picks {
+		if len(pick) == 1 {
+			singles = append(singles, i)
+		}
+	}
+	if len(singles) == 0 {
+		return
+	}
+
+	i := singles[rand.Intn(len(singles))]
+	for {
+		extra := randomOutcome()
+		if extra == picks[i] {
+			continue
+		}
+		picks[i], _ = lottery.NormalizeMatchPick(picks[i] + extra)
+		return
+	}
+}
+
+// buildMatchPoolGame monta uma aposta da Loteca com custo, retorno e probabilidade calculados
+func buildMatchPoolGame(ltype lottery.LotteryType, picks []string, prefs lottery.UserPreferences) *lottery.Game {
+	game := lottery.Game{Type: ltype, Picks: picks}
+	cost, err := lottery.GameCost(game)
+	if err != nil {
+		fmt.Printf("⚠️ %s: %v\n", game.Type, err)
+		return nil
+	}
+	game.Cost = cost
+
+	// Probabilidade de acertar as 14 partidas considerando os três resultados igualmente prováveis
+	combinations := 1.0
+	for _, pick := range picks {
+		combinations *= float64(len(pick))
+	}
+	game.Probability = combinations / math.Pow(3, float64(len(picks)))
+	game.ExpectedReturn = game.Probability * averagePrize(ltype, prefs)
+
+	return &game
+}
+
 // generateRandomNumber gera um número aleatório evitando exclusões
 func generateRandomNumber(maxRange int, exclude []int, prefs lottery.UserPreferences) int {
 	maxAttempts := 100
@@ -370,23 +691,15 @@ func generateReasoningText(strategy *lottery.Strategy, prefs lottery.UserPrefere
 	text := fmt.Sprintf("Estratégia %s otimizada para orçamento de R$ %.2f.\n\n",
 		prefs.Strategy, prefs.Budget)
 
-	megaCount := 0
-	lotoCount := 0
-
+	counts := make(map[lottery.LotteryType]int)
 	for _, game := range strategy.Games {
-		if game.Type == lottery.MegaSena {
-			megaCount++
-		} else {
-			lotoCount++
-		}
-	}
-
-	if megaCount > 0 {
-		text += fmt.Sprintf("• %d jogos da Mega Sena para maximizar prêmios altos\n", megaCount)
+		counts[game.Type]++
 	}
 
-	if lotoCount > 0 {
-		text += fmt.Sprintf("• %d jogos da Lotofácil para maior frequência de ganhos\n", lotoCount)
+	for _, def := range lottery.All() {
+		if counts[def.Type] > 0 {
+			text += fmt.Sprintf("• %s: %d jogos %s\n", def.Name(), counts[def.Type], def.StrategyNote)
+		}
 	}
 
 	text += "\nEsta estratégia foi otimizada considerando:\n"
@@ -418,7 +731,7 @@ func removeDuplicateGames(games []lottery.Game) []lottery.Game {
 	result := []lottery.Game{}
 
 	for _, game := range games {
-		key := fmt.Sprintf("%s:%v", game.Type, game.Numbers)
+		key := fmt.Sprintf("%s:%v:%v:%s:%v:%v", game.Type, game.Numbers, game.Secondary, game.ExtraPick, game.Columns, game.Picks)
 		if !seen[key] {
 			seen[key] = true
 			result = append(result, game)
@@ -437,17 +750,23 @@ func contains(slice []int, item int) bool {
 	return false
 }
 
-func calculateExpectedReturn(ltype lottery.LotteryType, numbers []int) float64 {
+func calculateExpectedReturn(ltype lottery.LotteryType, numbers []int, prefs lottery.UserPreferences) float64 {
 	// Cálculo simplificado - poderia ser mais sofisticado
 	prob := calculateProbability(ltype, len(numbers))
 
-	// Estimativa conservadora do prêmio médio
-	averagePrize := 1000000.0 // 1 milhão para Mega Sena
-	if ltype == lottery.Lotofacil {
-		averagePrize = 500000.0 // 500 mil para Lotofácil
-	}
+	return prob * averagePrize(ltype, prefs)
+}
 
-	return prob * averagePrize
+// averagePrize retorna o prêmio esperado da faixa principal
+// Usa a estimativa da CAIXA para o próximo concurso quando disponível; senão, a média conservadora da loteria
+func averagePrize(ltype lottery.LotteryType, prefs lottery.UserPreferences) float64 {
+	if estimate := prefs.PrizeEstimates[ltype]; estimate > 0 {
+		return estimate
+	}
+	if def, ok := lottery.Get(ltype); ok && def.AveragePrize > 0 {
+		return def.AveragePrize
+	}
+	return 1000000.0 // 1 milhão quando a loteria não informa estimativa
 }
 
 func calculateProbability(ltype lottery.LotteryType, numCount int) float64 {
@@ -455,18 +774,29 @@ func calculateProbability(ltype lottery.LotteryType, numCount int) float64 {
 
 	// Cálculo básico de probabilidade
 	// P = C(numCount, minNumbers) / C(range, minNumbers)
+	hits := rules.MinNumbers
+	if rules.MinNumbers == rules.MaxNumbers {
+		// Apostas de tamanho fixo (ex: Lotomania, Timemania): o prêmio máximo exige que todas as sorteadas estejam entre as apostadas
+		hits = rules.ResultNumbers
+	}
 
-	numerator := float64(calculateCombinations(numCount, rules.MinNumbers))
-	denominator := float64(calculateCombinations(rules.NumberRange, rules.MinNumbers))
+	numerator := calculateCombinations(numCount, hits)
+	denominator := calculateCombinations(rules.NumberRange, hits)
 
 	if denominator == 0 {
 		return 0
 	}
 
+	// Loterias com trevos: também é preciso acertar os trevos sorteados (considerando o mínimo apostado)
+	if rules.HasSecondary() {
+		denominator *= calculateCombinations(rules.SecondaryRange, rules.SecondaryResults)
+	}
+
 	return numerator / denominator
 }
 
-func calculateCombinations(n, r int) int {
+// calculateCombinations usa float64 para não estourar em volantes grandes como C(100, 20)
+func calculateCombinations(n, r int) float64 {
 	if r > n {
 		return 0
 	}
@@ -474,9 +804,9 @@ func calculateCombinations(n, r int) int {
 		return 1
 	}
 
-	result := 1
+	result := 1.0
 	for i := 0; i < r; i++ {
-		result = result * (n - i) / (i + 1)
+		result = result * float64(n-i) / float64(i+1)
 	}
 	return result
 }
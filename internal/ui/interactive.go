@@ -126,7 +126,7 @@ func generateStrategy() {
 	var failedLotteries []lottery.LotteryType
 
 	for _, ltype := range prefs.LotteryTypes {
-		draws, err := dataClient.GetLatestDraws(ltype, 50)
+		draws, err := dataClient.GetHistory(ltype, 50)
 		if err != nil {
 			red.Printf("❌ %s: %v\n", ltype, err)
 			failedLotteries = append(failedLotteries, ltype)
@@ -169,7 +169,7 @@ func generateStrategy() {
 		fmt.Println()
 		fmt.Println("💡 SUGESTÕES:")
 		fmt.Println("• Tente novamente mais tarde")
-		fmt.Println("• Considere incluir ambas as loterias (Mega Sena + Lotofácil)")
+		fmt.Printf("• Considere incluir outras loterias (%s)\n", strings.Join(lottery.Names(), ", "))
 		fmt.Println("• Verifique se a API da CAIXA está funcionando")
 		fmt.Println()
 		return
@@ -217,28 +217,60 @@ func generateStrategy() {
 func collectUserPreferences() (*lottery.UserPreferences, error) {
 	prefs := &lottery.UserPreferences{}
 
-	// Selecionar tipos de loteria
+	// Selecionar tipos de loteria (uma opção por loteria registrada, mais a estratégia mista)
+	defs := lottery.All()
+	items := make([]string, 0, len(defs)+1)
+	for _, def := range defs {
+		items = append(items, fmt.Sprintf("%s Apenas %s", def.Emoji, def.Name()))
+	}
+	items = append(items, "🌟 Todas (estratégia mista)")
+
 	lotteryPrompt := promptui.Select{
 		Label: "🎲 Quais loterias deseja jogar?",
-		Items: []string{
-			"🎯 Apenas Mega Sena",
-			"🍀 Apenas Lotofácil",
-			"🎲 Ambas (estratégia mista)",
-		},
+		Items: items,
 	}
 
-	_, lotteryChoice, err := lotteryPrompt.Run()
+	choice, _, err := lotteryPrompt.Run()
 	if err != nil {
 		return nil, err
 	}
 
-	switch lotteryChoice {
-	case "🎯 Apenas Mega Sena":
-		prefs.LotteryTypes = []lottery.LotteryType{lottery.MegaSena}
-	case "🍀 Apenas Lotofácil":
-		prefs.LotteryTypes = []lottery.LotteryType{lottery.Lotofacil}
-	default:
-		prefs.LotteryTypes = []lottery.LotteryType{lottery.MegaSena, lottery.Lotofacil}
+	if choice < len(defs) {
+		prefs.LotteryTypes = []lottery.LotteryType{defs[choice].Type}
+	} else {
+		// Loterias que exigem escolhas pessoais (ex: Time do Coração) ficam fora da estratégia mista
+		for _, def := range defs {
+			if def.InMixedStrategy {
+				prefs.LotteryTypes = append(prefs.LotteryTypes, def.Type)
+			}
+		}
+	}
+
+	// Palpite extra escolhido pelo apostador (ex: Time do Coração da Timemania)
+	for _, ltype := range prefs.LotteryTypes {
+		def, _ := lottery.Get(ltype)
+		if def.Rules.ExtraPickKind != lottery.ExtraPickTeam {
+			continue
+		}
+
+		teamPrompt := promptui.Prompt{
+			Label: fmt.Sprintf("%s Qual seu %s? (ex: FLAMENGO/RJ)", def.Emoji, def.Rules.ExtraPickName),
+			Validate: func(input string) error {
+				_, err := lottery.NormalizeExtraPick(ltype, input)
+				return err
+			},
+		}
+
+		team, err := teamPrompt.Run()
+		if err != nil {
+			return nil, err
+		}
+
+		team, _ = lottery.NormalizeExtraPick(ltype, team)
+		if prefs.ExtraPicks == nil {
+			prefs.ExtraPicks = make(map[lottery.LotteryType]string)
+		}
+		prefs.ExtraPicks[ltype] = team
 	}
 
 	// Orçamento
@@ -314,44 +346,22 @@ func displayStrategy(strategy *lottery.Strategy, confidence float64) {
 	fmt.Println()
 
 	// Agrupar jogos por tipo
-	megaSenaGames := []lottery.Game{}
-	lotofacilGames := []lottery.Game{}
-
+	gamesByType := make(map[lottery.LotteryType][]lottery.Game)
 	for _, game := range strategy.Games {
-		if game.Type == lottery.MegaSena {
-			megaSenaGames = append(megaSenaGames, game)
-		} else {
-			lotofacilGames = append(lotofacilGames, game)
-		}
+		gamesByType[game.Type] = append(gamesByType[game.Type], game)
 	}
 
-	// Exibir jogos da Mega Sena
-	if len(megaSenaGames) > 0 {
-		yellow.Println("🎯 MEGA SENA:")
-		for i, game := range megaSenaGames {
-			white.Printf("Jogo %d: ", i+1)
-			for j, num := range game.Numbers {
-				if j > 0 {
-					fmt.Print(" ")
-				}
-				fmt.Printf("%02d", num)
-			}
-			fmt.Printf(" (R$ %.2f)\n", game.Cost)
+	// Exibir jogos na ordem do registro de loterias
+	for _, def := range lottery.All() {
+		games := gamesByType[def.Type]
+		if len(games) == 0 {
+			continue
 		}
-		fmt.Println()
-	}
 
-	// Exibir jogos da Lotofácil
-	if len(lotofacilGames) > 0 {
-		yellow.Println("🍀 LOTOFÁCIL:")
-		for i, game := range lotofacilGames {
+		yellow.Printf("%s %s:\n", def.Emoji, strings.ToUpper(def.Name()))
+		for i, game := range games {
 			white.Printf("Jogo %d: ", i+1)
-			for j, num := range game.Numbers {
-				if j > 0 {
-					fmt.Print(" ")
-				}
-				fmt.Printf("%02d", num)
-			}
+			fmt.Print(formatGame(game, def.Rules))
 			fmt.Printf(" (R$ %.2f)\n", game.Cost)
 		}
 		fmt.Println()
@@ -539,19 +549,63 @@ func showNextDraws() {
 
 	cyan.Println("📅 PRÓXIMOS SORTEIOS:")
 
-	// Mega Sena
-	if nextDate, nextNum, err := dataClient.GetNextDrawInfo(lottery.MegaSena); err == nil {
-		fmt.Printf("• Mega Sena: Concurso %d em %s\n",
-			nextNum, nextDate.Format("02/01/2006"))
+	for _, def := range lottery.All() {
+		if nextDate, nextNum, err := dataClient.GetNextDrawInfo(def.Type); err == nil {
+			fmt.Printf("• %s: Concurso %d em %s\n",
+				def.Name(), nextNum, nextDate.Format("02/01/2006"))
+		}
 	}
 
-	// Lotofácil
-	if nextDate, nextNum, err := dataClient.GetNextDrawInfo(lottery.Lotofacil); err == nil {
-		fmt.Printf("• Lotofácil: Concurso %d em %s\n",
-			nextNum, nextDate.Format("02/01/2006"))
+	fmt.Println()
+}
+
+// formatGame formata as escolhas de um jogo conforme o tipo de aposta da loteria
+func formatGame(game lottery.Game, rules lottery.LotteryRules) string {
+	var b strings.Builder
+
+	// Apostas por colunas (ex: Super Sete): dígitos de cada coluna separados por " | "
+	if rules.IsPositional() {
+		for j, column := range game.Columns {
+			if j > 0 {
+				b.WriteString(" | ")
+			}
+			for k, digit := range column {
+				if k > 0 {
+					b.WriteString(",")
+				}
+				fmt.Fprintf(&b, "%d", digit)
+			}
+		}
+		return b.String()
 	}
 
-	fmt.Println()
+	// Loteca: palpite de cada partida
+	if rules.IsMatchPool() {
+		for j, pick := range game.Picks {
+			if j > 0 {
+				b.WriteString(" ")
+			}
+			fmt.Fprintf(&b, "%d:%s", j+1, pick)
+		}
+		return b.String()
+	}
+
+	// A dezena 100 (00 da Lotomania) é exibida como 00
+	for j, num := range game.Numbers {
+		if j > 0 {
+			b.WriteString(" ")
+		}
+		fmt.Fprintf(&b, "%02d", num%100)
+	}
+
+	if rules.HasSecondary() {
+		fmt.Fprintf(&b, " | %s%s: %v", strings.ToUpper(rules.SecondaryName[:1]), rules.SecondaryName[1:], game.Secondary)
+	}
+	if rules.HasExtraPick() {
+		fmt.Fprintf(&b, " | %s: %s", rules.ExtraPickName, game.ExtraPick)
+	}
+
+	return b.String()
 }
 
 func saveStrategy(strategy *lottery.Strategy) {